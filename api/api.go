@@ -8,12 +8,17 @@ import (
 	"goleague/api/routes"
 	"goleague/pkg/config"
 	"goleague/pkg/database"
+	"goleague/pkg/logger"
 	"goleague/pkg/models/champion"
+	"goleague/pkg/models/item"
 	"goleague/pkg/redis"
+	"goleague/pkg/tracing"
 	"log"
+	"log/slog"
 	"net/http"
 	"time"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -25,7 +30,20 @@ func main() {
 		log.Fatalf("Couldn't initialize the configuration: %v", err)
 	}
 
-	moduleDeps, cleanup, err := initializeModuleDependencies(cfg)
+	// The API never uploads its logs, only streams them.
+	apiLogger, err := logger.NewStreaming(cfg, "service", "api")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the logger: %v", err)
+	}
+	slog.SetDefault(apiLogger.Logger)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing, "goleague-api")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	moduleDeps, cleanup, err := initializeModuleDependencies(cfg, apiLogger)
 	if err != nil {
 		log.Fatalf("Couldn't initialize dependencies: %v", err)
 	}
@@ -51,9 +69,12 @@ func main() {
 	router := routes.NewRouter(module.Router)
 	router.SetupRoutes(
 		module.ChampionHandler,
+		module.LeaderboardHandler,
 		module.MatchHandler,
+		module.PatchHandler,
 		module.TierlistHandler,
 		module.PlayerHandler,
+		module.StatusHandler,
 	)
 
 	server := &http.Server{
@@ -71,7 +92,7 @@ func main() {
 }
 
 // initializeModuleDependencies starts all necessary dependencies.
-func initializeModuleDependencies(config *config.Config) (*modules.ModuleDependencies, func(), error) {
+func initializeModuleDependencies(config *config.Config, apiLogger *logger.Logger) (*modules.ModuleDependencies, func(), error) {
 	var cleanupFuncs []func()
 
 	cleanup := func() {
@@ -81,7 +102,11 @@ func initializeModuleDependencies(config *config.Config) (*modules.ModuleDepende
 	}
 	// Connect to the fetcher grpc.
 	fetcherHost := config.Grpc.Host + ":" + config.Grpc.Port
-	grpcClient, err := grpc.NewClient(fetcherHost, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcClient, err := grpc.NewClient(
+		fetcherHost,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
 	if err != nil {
 		cleanup()
 		return nil, nil, err
@@ -98,13 +123,19 @@ func initializeModuleDependencies(config *config.Config) (*modules.ModuleDepende
 	// Can run without, could implement it to not start withou.
 	redis, err := redis.NewClient(config.Redis)
 	if err != nil {
-		log.Printf("Warning: Error connecting to Redis: %v", err)
+		apiLogger.Warn("Error connecting to Redis", "error", err)
 	} else {
 		cleanupFuncs = append(cleanupFuncs, func() { redis.Client.Close() })
 	}
 
 	championMemCache := cache.NewMemCache[*champion.Champion]()
 	tierlistMemCache := cache.NewMemCache[[]*dto.TierlistResult]()
+	roleStatsMemCache := cache.NewMemCache[[]*dto.ChampionRoleStats]()
+	buildsMemCache := cache.NewMemCache[*dto.ChampionBuilds]()
+	skillsMemCache := cache.NewMemCache[*dto.ChampionSkills]()
+	matchupsMemCache := cache.NewMemCache[*dto.ChampionMatchups]()
+	synergiesMemCache := cache.NewMemCache[*dto.ChampionSynergies]()
+	itemMemCache := cache.NewMemCache[map[string]*item.Item]()
 
 	if sqlDB, err := db.DB(); err == nil {
 		cleanupFuncs = append(cleanupFuncs, func() { sqlDB.Close() })
@@ -119,6 +150,12 @@ func initializeModuleDependencies(config *config.Config) (*modules.ModuleDepende
 	cleanupFuncs = append(cleanupFuncs, func() { grpcClient.Close() })
 	cleanupFuncs = append(cleanupFuncs, func() { championMemCache.Close() })
 	cleanupFuncs = append(cleanupFuncs, func() { tierlistMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { roleStatsMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { buildsMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { skillsMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { matchupsMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { synergiesMemCache.Close() })
+	cleanupFuncs = append(cleanupFuncs, func() { itemMemCache.Close() })
 
 	// Pass down the dependencies.
 	moduleDeps := &modules.ModuleDependencies{
@@ -126,8 +163,17 @@ func initializeModuleDependencies(config *config.Config) (*modules.ModuleDepende
 		ChampionCache:    championCache,
 		GrpcClient:       grpcClient,
 		ChampionMemCache: championMemCache,
+		ItemCache:        cache.NewItemCache(db, redis, itemMemCache),
 		TierlistMemCache: tierlistMemCache,
+		Logger:           apiLogger,
 		Redis:            redis,
+		Regions:          config.Regions,
+
+		RoleStatsMemCache: roleStatsMemCache,
+		BuildsMemCache:    buildsMemCache,
+		SkillsMemCache:    skillsMemCache,
+		MatchupsMemCache:  matchupsMemCache,
+		SynergiesMemCache: synergiesMemCache,
 	}
 
 	return moduleDeps, cleanup, nil
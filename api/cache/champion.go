@@ -5,9 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	cacherepo "goleague/api/repositories/cache"
+	"goleague/pkg/metrics"
 	champmodel "goleague/pkg/models/champion"
 	"goleague/pkg/redis"
-	"log"
+	"log/slog"
 	"time"
 
 	"gorm.io/gorm"
@@ -16,6 +17,7 @@ import (
 // Default cache duration for the champion keys.
 const (
 	cacheDuration             = time.Hour
+	championCacheName         = "champion"
 	failedParsingChampionData = "failed to unmarshal champion data: %v"
 )
 
@@ -57,19 +59,26 @@ func (c *championCache) GetChampionCopy(ctx context.Context, championId string)
 
 	// Try to get directly from memory.
 	if champCache := c.memCache.Get(cacheKey); champCache != nil {
+		metrics.CacheHit(championCacheName, metrics.CacheLayerMemory)
 		return champCache, nil
 	}
+	metrics.CacheMiss(championCacheName, metrics.CacheLayerMemory)
 
 	// Get from the redis if doesn't found.
 	champRedis, err := c.redis.Get(ctx, cacheKey)
 	if err != nil {
+		metrics.CacheMiss(championCacheName, metrics.CacheLayerRedis)
+
 		// Get from the database fallback in that case.
 		// It will be way slower, but will save in memory for the next requests.
 		champRedis, err = c.cacheRepository.GetKey(cacheKey)
 		if err != nil {
 			// Everything went wrong.
+			metrics.QueryError(championCacheName)
 			return nil, fmt.Errorf("error getting from the database fallback: %w", err)
 		}
+	} else {
+		metrics.CacheHit(championCacheName, metrics.CacheLayerRedis)
 	}
 
 	// Unmarshal as a generic map.
@@ -92,7 +101,7 @@ func (c *championCache) Initialize(ctx context.Context) error {
 	// Get all the keys by prefix.
 	keys, err := c.redis.GetKeysByPrefix(ctx, cachePrefix)
 	if err != nil {
-		log.Printf("Failed pre-loading Redis champion keys: %v", err)
+		slog.ErrorContext(ctx, "Failed pre-loading Redis champion keys", "error", err)
 
 		// Get all champions by the prefix and save in memory.
 		champions, _ := c.cacheRepository.GetByPrefix(cachePrefix)
@@ -132,7 +141,7 @@ func (c *championCache) GetAllChampions(ctx context.Context) ([]*champmodel.Cham
 	// Try to get all keys from Redis.
 	keys, err := c.redis.GetKeysByPrefix(ctx, cachePrefix)
 	if err != nil || len(keys) == 0 {
-		log.Printf("Failed getting all champions from Redis: %v", err)
+		slog.WarnContext(ctx, "Failed getting all champions from Redis, using the database fallback", "error", err)
 		// Fallback: load champions from persistent cache.
 		champions, dbErr := c.cacheRepository.GetByPrefix(cachePrefix)
 		if dbErr != nil {
@@ -142,7 +151,7 @@ func (c *championCache) GetAllChampions(ctx context.Context) ([]*champmodel.Cham
 		for _, champion := range champions {
 			var champJson *champmodel.Champion
 			if err := json.Unmarshal([]byte(champion.CacheValue), &champJson); err != nil {
-				log.Printf(failedParsingChampionData, err)
+				slog.ErrorContext(ctx, "Failed to unmarshal champion data", "key", champion.CacheKey, "error", err)
 				continue
 			}
 
@@ -156,7 +165,7 @@ func (c *championCache) GetAllChampions(ctx context.Context) ([]*champmodel.Cham
 	for _, key := range keys {
 		champRedis, err := c.redis.Get(ctx, key)
 		if err != nil {
-			log.Printf("Failed getting Redis key %s: %v", key, err)
+			slog.ErrorContext(ctx, "Failed getting Redis key", "key", key, "error", err)
 			continue
 		}
 
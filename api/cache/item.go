@@ -0,0 +1,121 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	cacherepo "goleague/api/repositories/cache"
+	"goleague/pkg/metrics"
+	itemmodel "goleague/pkg/models/item"
+	"goleague/pkg/redis"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+const (
+	itemCacheName         = "item"
+	itemCachePrefix       = "ddragon:item:"
+	failedParsingItemData = "failed to unmarshal item data: %v"
+)
+
+type ItemCache interface {
+	GetAllItems(ctx context.Context) (map[string]*itemmodel.Item, error)
+}
+
+type ItemCacheRedisClient interface {
+	Get(ctx context.Context, key string) (string, error)
+	GetKeysByPrefix(ctx context.Context, prefix string) ([]string, error)
+}
+
+// itemCache keeps the whole item list in memory, the builds always need every item.
+// Uses db as a fallback as last resource if Redis isn't available.
+type itemCache struct {
+	memCache        MemCache[map[string]*itemmodel.Item]
+	redis           ItemCacheRedisClient
+	cacheRepository cacherepo.CacheRepository
+}
+
+// NewItemCache creates the instance of the item cache.
+func NewItemCache(db *gorm.DB, redis *redis.RedisClient, memCache MemCache[map[string]*itemmodel.Item]) ItemCache {
+	return &itemCache{
+		memCache:        memCache,
+		redis:           redis,
+		cacheRepository: cacherepo.NewCacheRepository(db),
+	}
+}
+
+// GetAllItems returns every cached item by its id.
+// The returned map is shared, it must not be changed.
+func (c *itemCache) GetAllItems(ctx context.Context) (map[string]*itemmodel.Item, error) {
+	if items := c.memCache.Get(itemCachePrefix); items != nil {
+		metrics.CacheHit(itemCacheName, metrics.CacheLayerMemory)
+		return items, nil
+	}
+	metrics.CacheMiss(itemCacheName, metrics.CacheLayerMemory)
+
+	items, err := c.getRedisItems(ctx)
+	if err != nil || len(items) == 0 {
+		metrics.CacheMiss(itemCacheName, metrics.CacheLayerRedis)
+		slog.WarnContext(ctx, "Failed getting all items from Redis, using the database fallback", "error", err)
+
+		// Fallback: load items from persistent cache.
+		items, err = c.getDatabaseItems()
+		if err != nil {
+			metrics.QueryError(itemCacheName)
+			return nil, fmt.Errorf("failed to load items from fallback cache: %w", err)
+		}
+	} else {
+		metrics.CacheHit(itemCacheName, metrics.CacheLayerRedis)
+	}
+
+	c.memCache.Set(itemCachePrefix, items, cacheDuration)
+	return items, nil
+}
+
+// getRedisItems loads every item key from Redis.
+func (c *itemCache) getRedisItems(ctx context.Context) (map[string]*itemmodel.Item, error) {
+	keys, err := c.redis.GetKeysByPrefix(ctx, itemCachePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]*itemmodel.Item, len(keys))
+	for _, key := range keys {
+		itemRedis, err := c.redis.Get(ctx, key)
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed getting Redis key", "key", key, "error", err)
+			continue
+		}
+
+		var itemJson *itemmodel.Item
+		if err := json.Unmarshal([]byte(itemRedis), &itemJson); err != nil {
+			return nil, fmt.Errorf(failedParsingItemData, err)
+		}
+
+		items[itemJson.ID] = itemJson
+	}
+
+	return items, nil
+}
+
+// getDatabaseItems loads every item from the database fallback.
+func (c *itemCache) getDatabaseItems() (map[string]*itemmodel.Item, error) {
+	entries, err := c.cacheRepository.GetByPrefix(itemCachePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]*itemmodel.Item, len(entries))
+	for _, entry := range entries {
+		var itemJson *itemmodel.Item
+		if err := json.Unmarshal([]byte(entry.CacheValue), &itemJson); err != nil {
+			slog.Error("Failed to unmarshal item data", "key", entry.CacheKey, "error", err)
+			continue
+		}
+
+		items[itemJson.ID] = itemJson
+	}
+
+	return items, nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"goleague/api/dto"
+	"goleague/pkg/metrics"
 	"goleague/pkg/redis"
 	"time"
 )
@@ -13,6 +14,7 @@ import (
 const (
 	matchPreviewCacheDuration = time.Hour
 	matchPreviewKey           = "match:previews:%d"
+	matchPreviewCacheName     = "match_preview"
 )
 
 // MatchCache is the public interface for accessing the player repository.
@@ -43,6 +45,9 @@ func (mc *matchCache) GetMatchesPreviewByMatchIds(ctx context.Context, matchIds
 	}
 	results, err := mc.redis.MGet(ctx, keys...).Result()
 	if err != nil {
+		metrics.CacheRequestsTotal.
+			WithLabelValues(matchPreviewCacheName, metrics.CacheLayerRedis, metrics.CacheResultMiss).
+			Add(float64(len(matchIds)))
 		return nil, nil, err
 	}
 
@@ -67,6 +72,13 @@ func (mc *matchCache) GetMatchesPreviewByMatchIds(ctx context.Context, matchIds
 		}
 	}
 
+	metrics.CacheRequestsTotal.
+		WithLabelValues(matchPreviewCacheName, metrics.CacheLayerRedis, metrics.CacheResultHit).
+		Add(float64(len(foundMatches)))
+	metrics.CacheRequestsTotal.
+		WithLabelValues(matchPreviewCacheName, metrics.CacheLayerRedis, metrics.CacheResultMiss).
+		Add(float64(len(notFoundIds)))
+
 	return foundMatches, notFoundIds, nil
 }
 
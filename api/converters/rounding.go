@@ -0,0 +1,16 @@
+package converters
+
+import "math"
+
+// RoundTwoDecimals rounds to two decimals, the same precision of the stats and rates returned by the database.
+func RoundTwoDecimals(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// Percentage returns the percentage of the value on the total, zero without a total.
+func Percentage(value int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return RoundTwoDecimals(float64(value) / float64(total) * 100)
+}
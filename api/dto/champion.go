@@ -0,0 +1,130 @@
+package dto
+
+import (
+	"goleague/pkg/models/champion"
+	"goleague/pkg/models/image"
+)
+
+// ChampionData is the champion with its statistics, the champion fields are kept on the root.
+type ChampionData struct {
+	*champion.Champion
+	Patch string               `json:"patch,omitempty"`
+	Stats []*ChampionRoleStats `json:"stats"`
+}
+
+// ChampionRoleStats is the performance of a champion on a given position.
+type ChampionRoleStats struct {
+	Matches      int64   `json:"matches"`
+	TeamPosition string  `json:"teamPosition"`
+	WinRate      float64 `json:"winRate"`
+}
+
+// ChampionBuilds are the item choices of a champion, each with the most common and the best performing options.
+type ChampionBuilds struct {
+	ChampionId    string        `json:"championId"`
+	Matches       int           `json:"matches"`
+	Patch         string        `json:"patch,omitempty"`
+	Role          string        `json:"role,omitempty"`
+	StartingItems *BuildOptions `json:"startingItems"`
+	CoreItems     *BuildOptions `json:"coreItems"`
+	Boots         *BuildOptions `json:"boots"`
+}
+
+// BuildOptions are the options of a build step, by popularity and by win rate.
+type BuildOptions struct {
+	MostCommon     []*BuildOption `json:"mostCommon"`
+	HighestWinRate []*BuildOption `json:"highestWinRate"`
+}
+
+// BuildOption is a set of items, in the order they are bought.
+type BuildOption struct {
+	Items    []*BuildItem `json:"items"`
+	Matches  int          `json:"matches"`
+	PickRate float64      `json:"pickRate"`
+	WinRate  float64      `json:"winRate"`
+}
+
+// BuildItem is the item of a build option.
+type BuildItem struct {
+	ID    string      `json:"id"`
+	Name  string      `json:"name"`
+	Image image.Image `json:"image"`
+}
+
+// ChampionSkills are the skill orders of a champion, each with the most common and the best performing options.
+type ChampionSkills struct {
+	ChampionId  string        `json:"championId"`
+	Matches     int           `json:"matches"`
+	Patch       string        `json:"patch,omitempty"`
+	Role        string        `json:"role,omitempty"`
+	MaxOrder    *SkillOptions `json:"maxOrder"`
+	FirstLevels *SkillOptions `json:"firstLevels"`
+}
+
+// SkillOptions are the options of a skill order, by popularity and by win rate.
+type SkillOptions struct {
+	MostCommon     []*SkillOrder `json:"mostCommon"`
+	HighestWinRate []*SkillOrder `json:"highestWinRate"`
+}
+
+// SkillOrder is a sequence of skills, like Q>E>W.
+type SkillOrder struct {
+	Skills   []*Skill `json:"skills"`
+	Matches  int      `json:"matches"`
+	PickRate float64  `json:"pickRate"`
+	WinRate  float64  `json:"winRate"`
+}
+
+// Skill is a champion spell on a skill order.
+type Skill struct {
+	Key   string      `json:"key"`
+	Slot  int         `json:"slot"`
+	Name  string      `json:"name"`
+	Image image.Image `json:"image"`
+}
+
+// ChampionMatchups are the lane opponents of a champion, most played first.
+type ChampionMatchups struct {
+	ChampionId string             `json:"championId"`
+	Patch      string             `json:"patch,omitempty"`
+	Role       string             `json:"role,omitempty"`
+	Matchups   []*ChampionMatchup `json:"matchups"`
+}
+
+// ChampionMatchup is the performance against an opponent, the differences are from the champion side.
+// The differences are null when no match reached the minute.
+type ChampionMatchup struct {
+	OpponentId    string      `json:"opponentId"`
+	OpponentName  string      `json:"opponentName"`
+	OpponentImage image.Image `json:"opponentImage"`
+	TeamPosition  string      `json:"teamPosition"`
+	Matches       int64       `json:"matches"`
+	WinRate       float64     `json:"winRate"`
+	GoldDiffAt10  *float64    `json:"goldDiffAt10"`
+	XpDiffAt10    *float64    `json:"xpDiffAt10"`
+	CsDiffAt10    *float64    `json:"csDiffAt10"`
+	GoldDiffAt15  *float64    `json:"goldDiffAt15"`
+	XpDiffAt15    *float64    `json:"xpDiffAt15"`
+	CsDiffAt15    *float64    `json:"csDiffAt15"`
+}
+
+// ChampionSynergies are the allies of a champion, best synergy first.
+type ChampionSynergies struct {
+	ChampionId string             `json:"championId"`
+	Patch      string             `json:"patch,omitempty"`
+	Role       string             `json:"role,omitempty"`
+	Synergies  []*ChampionSynergy `json:"synergies"`
+}
+
+// ChampionSynergy is the performance with an ally, compared to the expected from both win rates alone.
+type ChampionSynergy struct {
+	AllyId          string      `json:"allyId"`
+	AllyName        string      `json:"allyName"`
+	AllyImage       image.Image `json:"allyImage"`
+	AllyPosition    string      `json:"allyPosition"`
+	TeamPosition    string      `json:"teamPosition"`
+	Matches         int64       `json:"matches"`
+	WinRate         float64     `json:"winRate"`
+	ExpectedWinRate float64     `json:"expectedWinRate"`
+	Synergy         float64     `json:"synergy"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+// Leaderboard is a page of the ladder of a region.
+type Leaderboard struct {
+	Region  string              `json:"region"`
+	Queue   string              `json:"queue"`
+	Tier    string              `json:"tier,omitempty"`
+	Page    int                 `json:"page"`
+	Entries []*LeaderboardEntry `json:"entries"`
+}
+
+// LeaderboardEntry is a player on the ladder.
+// The position change is null for players that weren't ranked on the previous day.
+type LeaderboardEntry struct {
+	Position       int                    `json:"position"`
+	PositionChange *int                   `json:"positionChange"`
+	Id             uint                   `json:"id"`
+	Name           string                 `json:"name"`
+	Tag            string                 `json:"tag"`
+	ProfileIcon    int                    `json:"profileIconId"`
+	Tier           string                 `json:"tier"`
+	Rank           string                 `json:"rank"`
+	LeaguePoints   int                    `json:"lp"`
+	Wins           int                    `json:"wins"`
+	Losses         int                    `json:"losses"`
+	WinRate        float64                `json:"winRate"`
+	Champions      []*LeaderboardChampion `json:"champions"`
+}
+
+// LeaderboardChampion is one of the most played champions of a player.
+type LeaderboardChampion struct {
+	ChampionId int   `json:"championId"`
+	Matches    int64 `json:"matches"`
+}
@@ -34,6 +34,14 @@ type MatchPreviewData struct {
 // MatchPreviewList is a map with the match ids as keys and the match data as values.
 type MatchPreviewList map[string]*MatchPreview
 
+// MatchHistoryPage is a page of the player match history.
+// The previews are keyed by the match id, the ids keep the order of the matches, newest first.
+type MatchHistoryPage struct {
+	Matches    MatchPreviewList
+	Ids        []string
+	NextCursor *string
+}
+
 // MatchPreviewMetadata holds a given match metadata.
 type MatchPreviewMetadata struct {
 	AverageElo   string    `json:"averageElo"`
@@ -59,6 +67,7 @@ type FullMatchData struct {
 	ParticipantsPreviews []*MatchPreviewData   `json:"participants"`
 	ParticipantFrames    ParticipantFrameList  `json:"participant_frames"`
 	Events               []MatchEvents         `json:"events"`
+	TimelinePruned       bool                  `json:"timeline_pruned"`
 }
 
 // ParticipantFrameList is the map of frames for each participant in a given match.
@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// Patch is a game patch with the amount of stored matches.
+type Patch struct {
+	FirstMatch time.Time `json:"firstMatch"`
+	LastMatch  time.Time `json:"lastMatch"`
+	Matches    int64     `json:"matches"`
+	Patch      string    `json:"patch"`
+}
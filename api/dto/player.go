@@ -1,5 +1,7 @@
 package dto
 
+import "time"
+
 // PlayerSearch is the type of a player search result.
 type PlayerSearch struct {
 	Id            uint   `json:"id"`
@@ -12,14 +14,21 @@ type PlayerSearch struct {
 }
 
 // StatsEntry is a single stat entry to be returned.
+// The differences are against the lane opponent, null without timeline data.
 type StatsEntry struct {
-	Matches        int     `json:"matches"`
-	WinRate        float32 `json:"winRate"`
-	AverageKills   float32 `json:"averageKills"`
-	AverageDeaths  float32 `json:"averageDeaths"`
-	AverageAssists float32 `json:"averageAssists"`
-	CsPerMin       float32 `json:"csPerMin"`
-	KDA            float32 `json:"kda"`
+	Matches        int      `json:"matches"`
+	WinRate        float32  `json:"winRate"`
+	AverageKills   float32  `json:"averageKills"`
+	AverageDeaths  float32  `json:"averageDeaths"`
+	AverageAssists float32  `json:"averageAssists"`
+	CsPerMin       float32  `json:"csPerMin"`
+	KDA            float32  `json:"kda"`
+	GoldDiffAt10   *float32 `json:"goldDiffAt10"`
+	XpDiffAt10     *float32 `json:"xpDiffAt10"`
+	CsDiffAt10     *float32 `json:"csDiffAt10"`
+	GoldDiffAt15   *float32 `json:"goldDiffAt15"`
+	XpDiffAt15     *float32 `json:"xpDiffAt15"`
+	CsDiffAt15     *float32 `json:"csDiffAt15"`
 }
 
 // PlayerStatsQueue represents different metrics for a given player stats in a given queueId.
@@ -54,3 +63,108 @@ type RatingInfo struct {
 	Losses       int    `json:"losses"`
 	Region       string `json:"region"`
 }
+
+// RatingHistory is the rating timeline of a player on a queue, with the peak of each season.
+// Long ranges are daily, with the last rating of each day.
+type RatingHistory struct {
+	Queue   string               `json:"queue"`
+	Daily   bool                 `json:"daily"`
+	Entries []RatingHistoryEntry `json:"entries"`
+	Peaks   []RatingPeak         `json:"peaks"`
+}
+
+// RatingHistoryEntry is the rating of a player at a given time.
+type RatingHistoryEntry struct {
+	Tier         string    `json:"tier"`
+	Rank         string    `json:"rank"`
+	LeaguePoints int       `json:"lp"`
+	NumericScore int       `json:"numericScore"`
+	Wins         int       `json:"wins"`
+	Losses       int       `json:"losses"`
+	FetchTime    time.Time `json:"fetchTime"`
+}
+
+// RatingPeak is the highest rating of a player on a season.
+type RatingPeak struct {
+	Season       int       `json:"season"`
+	Tier         string    `json:"tier"`
+	Rank         string    `json:"rank"`
+	LeaguePoints int       `json:"lp"`
+	NumericScore int       `json:"numericScore"`
+	FetchTime    time.Time `json:"fetchTime"`
+}
+
+// DuoPartner is a player that was on the same team as the searched player on recent matches.
+// Partners that played consecutive matches together were likely on a premade.
+type DuoPartner struct {
+	Id               uint    `json:"id"`
+	Name             string  `json:"name"`
+	Tag              string  `json:"tag"`
+	ProfileIcon      int     `json:"profileIconId"`
+	Matches          int     `json:"matches"`
+	Wins             int     `json:"wins"`
+	WinRate          float64 `json:"winRate"`
+	ConsecutiveGames int     `json:"consecutiveGames"`
+	LikelyPremade    bool    `json:"likelyPremade"`
+}
+
+// RecentSummary summarizes the last matches of a player.
+// The streak is positive for consecutive wins and negative for consecutive losses, up to the newest match.
+type RecentSummary struct {
+	Matches           int               `json:"matches"`
+	Wins              int               `json:"wins"`
+	Losses            int               `json:"losses"`
+	WinRate           float64           `json:"winRate"`
+	AverageKills      float64           `json:"averageKills"`
+	AverageDeaths     float64           `json:"averageDeaths"`
+	AverageAssists    float64           `json:"averageAssists"`
+	KDA               float64           `json:"kda"`
+	KillParticipation float64           `json:"killParticipation"`
+	Roles             map[string]int    `json:"roles"`
+	Champions         []*RecentChampion `json:"champions"`
+	Streak            int               `json:"streak"`
+}
+
+// RecentChampion is a champion played on the recent matches.
+type RecentChampion struct {
+	ChampionId int     `json:"championId"`
+	Matches    int     `json:"matches"`
+	Wins       int     `json:"wins"`
+	WinRate    float64 `json:"winRate"`
+	KDA        float64 `json:"kda"`
+}
+
+// PlayerComparison compares players side by side, every list follows the requested order of the players.
+type PlayerComparison struct {
+	Players         []*ComparedPlayer  `json:"players"`
+	SharedChampions []*SharedChampion  `json:"sharedChampions"`
+	Encounters      []*PlayerEncounter `json:"encounters"`
+}
+
+// ComparedPlayer is a compared player with the current ratings and the stats over the interval.
+type ComparedPlayer struct {
+	Id          uint            `json:"id"`
+	Name        string          `json:"name"`
+	ProfileIcon int             `json:"profileIconId"`
+	Region      string          `json:"region"`
+	Tag         string          `json:"tag"`
+	Rating      []RatingInfo    `json:"rating"`
+	Stats       FullPlayerStats `json:"stats"`
+}
+
+// SharedChampion is a champion on the most played of every compared player, with the stats of each one.
+type SharedChampion struct {
+	ChampionId int           `json:"championId"`
+	Stats      []*StatsEntry `json:"stats"`
+}
+
+// PlayerEncounter is the matches of two of the compared players on the same and on opposite teams.
+// The wins are of the first player.
+type PlayerEncounter struct {
+	FirstPlayerId  uint `json:"firstPlayerId"`
+	SecondPlayerId uint `json:"secondPlayerId"`
+	Together       int  `json:"together"`
+	TogetherWins   int  `json:"togetherWins"`
+	Against        int  `json:"against"`
+	AgainstWins    int  `json:"againstWins"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+import "time"
+
+// CrawlCursor is the current page of a given league crawl.
+type CrawlCursor struct {
+	CurrentPage int       `json:"currentPage"`
+	Queue       string    `json:"queue"`
+	Rank        string    `json:"rank"`
+	Tier        string    `json:"tier"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// CrawlStatus is the league crawl state of a sub region.
+type CrawlStatus struct {
+	Cursors            []CrawlCursor `json:"cursors"`
+	LastCompletedCycle *time.Time    `json:"lastCompletedCycle"`
+	Region             string        `json:"region"`
+}
+
+// FailureReason is the amount of failed matches of a given reason.
+type FailureReason struct {
+	Attempts      int64     `json:"attempts"`
+	LastFailureAt time.Time `json:"lastFailureAt"`
+	Permanent     int64     `json:"permanent"`
+	Reason        string    `json:"reason"`
+	Retrying      int64     `json:"retrying"`
+	Total         int64     `json:"total"`
+}
@@ -3,14 +3,22 @@ package dto
 import tierlistrepo "goleague/api/repositories/tierlist"
 
 // Result of a tierlist fetch.
+// The adjusted win rate is smoothed towards the role average, the lower and upper are the 95% confidence interval.
+// The score is the adjusted win rate above the role average, plus the pick and ban rates.
 type TierlistResult struct {
-	BanCount     int     `json:"banCount"`
-	BanRate      float64 `json:"banRate"`
-	ChampionId   int     `json:"championId"`
-	PickCount    int     `json:"pickCount"`
-	PickRate     float64 `json:"pickRate"`
-	TeamPosition string  `json:"teamPosition"`
-	WinRate      float64 `json:"winRate"`
+	AdjustedWinRate float64 `json:"adjustedWinRate"`
+	BanCount        int     `json:"banCount"`
+	BanRate         float64 `json:"banRate"`
+	ChampionId      int     `json:"championId"`
+	Grade           string  `json:"grade"`
+	PickCount       int     `json:"pickCount"`
+	PickRate        float64 `json:"pickRate"`
+	Score           float64 `json:"score"`
+	TeamPosition    string  `json:"teamPosition"`
+	WinRate         float64 `json:"winRate"`
+	WinRateLower    float64 `json:"winRateLower"`
+	WinRateUpper    float64 `json:"winRateUpper"`
+	Wins            int     `json:"wins"`
 }
 
 // FromRepositorySlice creates the DTO from the repository result (Same structure)
@@ -26,6 +34,7 @@ func (TierlistResult) FromRepositorySlice(repoResults []*tierlistrepo.TierlistRe
 			PickRate:     repo.PickRate,
 			TeamPosition: repo.TeamPosition,
 			WinRate:      repo.WinRate,
+			Wins:         repo.Wins,
 		}
 	}
 
@@ -1,16 +1,122 @@
 package filters
 
+import (
+	"fmt"
+	patchvalues "goleague/pkg/riotvalues/patch"
+	positionvalues "goleague/pkg/riotvalues/position"
+	tiervalues "goleague/pkg/riotvalues/tier"
+	"time"
+)
+
 // URI params for the champion endpoitns.
 type ChampionURIParams struct {
 	ChampionId string `uri:"championId" binding:"required"`
 }
 
+// Query parameters for the champion data filters.
+// Without a patch, the window defaults to DefaultStatsDays.
+type ChampionQueryParams struct {
+	Patch string `form:"patch"`
+	Queue int    `form:"queue"`
+	Days  int    `form:"days" binding:"min=0,max=90"`
+}
+
 type GetChampionDataFilter struct {
 	ChampionId string
+	Patch      string
+	Queue      int
+	Days       int
+	Since      time.Time
+}
+
+// NewGetChampionDataFilter creates the filter, the stats default to the ranked solo queue.
+func NewGetChampionDataFilter(pp *ChampionURIParams, qp ChampionQueryParams) *GetChampionDataFilter {
+	filters := &GetChampionDataFilter{
+		ChampionId: pp.ChampionId,
+		Patch:      qp.Patch,
+		Queue:      420,
+		Days:       defaultStatsDays(qp.Days, qp.Patch, time.Time{}),
+	}
+
+	if qp.Queue != 0 {
+		filters.Queue = qp.Queue
+	}
+
+	if filters.Days != 0 {
+		filters.Since = time.Now().AddDate(0, 0, -filters.Days)
+	}
+
+	return filters
+}
+
+// Query parameters for the champion statistics, shared by the builds, skill orders and matchups.
+// Without a patch, the window defaults to DefaultStatsDays.
+type ChampionStatsQueryParams struct {
+	Role      string `form:"role"`
+	Tier      string `form:"tier"`
+	AboveTier bool   `form:"above_tiers"`
+	Queue     int    `form:"queue"`
+	Patch     string `form:"patch"`
+	Days      int    `form:"days" binding:"min=0,max=90"`
+}
+
+// Validate checks the patch, role and tier of the champion statistics.
+func (qp ChampionStatsQueryParams) Validate() error {
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRole(qp.Role); err != nil {
+		return err
+	}
+
+	if qp.Tier != "" {
+		if lower, higher := tiervalues.GetTierLimits(qp.Tier); lower == 0 && higher == 0 {
+			return fmt.Errorf("invalid tier %s", qp.Tier)
+		}
+	}
+
+	return nil
+}
+
+type ChampionStatsFilter struct {
+	ChampionId string
+	Role       string
+	MinRating  int
+	MaxRating  int
+	Queue      int
+	Patch      string
+	Days       int
+	Since      time.Time
 }
 
-func NewGetChampionDataFilter(pp *ChampionURIParams) *GetChampionDataFilter {
-	return &GetChampionDataFilter{
+// NewChampionStatsFilter creates the filter, with the same defaults of the champion data.
+// The tier is converted to the range of the match average rating.
+func NewChampionStatsFilter(pp *ChampionURIParams, qp ChampionStatsQueryParams) *ChampionStatsFilter {
+	filters := &ChampionStatsFilter{
 		ChampionId: pp.ChampionId,
+		Role:       positionvalues.Normalize(qp.Role),
+		Patch:      qp.Patch,
+		Queue:      420,
+		Days:       defaultStatsDays(qp.Days, qp.Patch, time.Time{}),
+	}
+
+	if qp.Queue != 0 {
+		filters.Queue = qp.Queue
+	}
+
+	if qp.Tier != "" {
+		filters.MinRating, filters.MaxRating = tiervalues.GetTierLimits(qp.Tier)
+		if qp.AboveTier {
+			filters.MaxRating = 0
+		}
+	}
+
+	if filters.Days != 0 {
+		filters.Since = time.Now().AddDate(0, 0, -filters.Days)
 	}
+
+	return filters
 }
@@ -0,0 +1,77 @@
+package filters
+
+import (
+	"fmt"
+	"goleague/pkg/regions"
+	tiervalues "goleague/pkg/riotvalues/tier"
+	"strings"
+)
+
+// LeaderboardPageSize is the amount of players on each leaderboard page.
+const LeaderboardPageSize = 50
+
+// URI params for the leaderboard endpoints.
+type LeaderboardURIParams struct {
+	Region string `uri:"region" binding:"required"`
+}
+
+// Queues of the rating entries by the ranked queue id, as returned by the league endpoints.
+var ratingQueues = map[int]string{
+	420: "RANKED_SOLO_5x5",
+	440: "RANKED_FLEX_SR",
+}
+
+// Query parameters for the leaderboard filters.
+type LeaderboardQueryParams struct {
+	Queue int    `form:"queue"`
+	Tier  string `form:"tier"`
+	Page  int    `form:"page" binding:"min=0"`
+}
+
+// Validate checks the region, the ranked queue and the tier of the leaderboard.
+// The region must be enabled on the region config.
+func (qp LeaderboardQueryParams) Validate(pp *LeaderboardURIParams, rc *regions.RegionConfig) error {
+	if err := ValidateRegion(rc, pp.Region); err != nil {
+		return err
+	}
+
+	if _, exists := ratingQueues[qp.Queue]; qp.Queue != 0 && !exists {
+		return fmt.Errorf("invalid queue %d, only the ranked queues have ratings", qp.Queue)
+	}
+
+	if qp.Tier != "" {
+		if lower, higher := tiervalues.GetTierLimits(qp.Tier); lower == 0 && higher == 0 {
+			return fmt.Errorf("invalid tier %s", qp.Tier)
+		}
+	}
+
+	return nil
+}
+
+// LeaderboardFilter is the leaderboard filter, with the queue as stored on the ratings.
+type LeaderboardFilter struct {
+	Region   string
+	Queue    string
+	QueueId  int
+	Tier     string
+	Page     int
+	PageSize int
+}
+
+// NewLeaderboardFilter creates the filter, defaulting to the first page of the ranked solo queue.
+func NewLeaderboardFilter(pp *LeaderboardURIParams, qp LeaderboardQueryParams) *LeaderboardFilter {
+	filters := &LeaderboardFilter{
+		Region:   strings.ToUpper(pp.Region),
+		QueueId:  420,
+		Tier:     strings.ToUpper(qp.Tier),
+		Page:     max(qp.Page, 1),
+		PageSize: LeaderboardPageSize,
+	}
+
+	if qp.Queue != 0 {
+		filters.QueueId = qp.Queue
+	}
+	filters.Queue = ratingQueues[filters.QueueId]
+
+	return filters
+}
@@ -0,0 +1,77 @@
+package filters
+
+import (
+	"goleague/pkg/regions"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeaderboardQueryParamsValidate(t *testing.T) {
+	rc, err := regions.NewRegionConfig([]string{"BR1", "NA1"}, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		region        string
+		query         string
+		regions       *regions.RegionConfig
+		expectedError string
+	}{
+		{name: "enabledRegion", region: "br1", regions: rc},
+		{name: "disabledRegion", region: "kr", regions: rc, expectedError: "the region kr isn't supported"},
+		{name: "unknownRegion", region: "xx9", regions: rc, expectedError: "xx9"},
+		{name: "anyKnownRegionWithoutConfig", region: "kr"},
+		{name: "unknownRegionWithoutConfig", region: "xx9", expectedError: "xx9"},
+		{name: "flexQueue", region: "br1", query: "queue=440", regions: rc},
+		{name: "unrankedQueue", region: "br1", query: "queue=450", regions: rc, expectedError: "invalid queue 450"},
+		{name: "tier", region: "br1", query: "tier=gold", regions: rc},
+		{name: "invalidTier", region: "br1", query: "tier=wood", regions: rc, expectedError: "invalid tier wood"},
+		{name: "negativePage", region: "br1", query: "page=-1", regions: rc, expectedError: "Page"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var qp LeaderboardQueryParams
+			req := httptest.NewRequest("GET", "/leaderboard/"+tt.region+"?"+tt.query, nil)
+
+			err := binding.Query.Bind(req, &qp)
+			if err == nil {
+				err = qp.Validate(&LeaderboardURIParams{Region: tt.region}, tt.regions)
+			}
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewLeaderboardFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		params        LeaderboardQueryParams
+		expectedQueue string
+		expectedPage  int
+	}{
+		{name: "defaultQueue", params: LeaderboardQueryParams{}, expectedQueue: "RANKED_SOLO_5x5", expectedPage: 1},
+		{name: "flexQueue", params: LeaderboardQueryParams{Queue: 440, Page: 3}, expectedQueue: "RANKED_FLEX_SR", expectedPage: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := NewLeaderboardFilter(&LeaderboardURIParams{Region: "br1"}, tt.params)
+
+			assert.Equal(t, "BR1", filters.Region)
+			assert.Equal(t, tt.expectedQueue, filters.Queue)
+			assert.Equal(t, tt.expectedPage, filters.Page)
+			assert.Equal(t, LeaderboardPageSize, filters.PageSize)
+		})
+	}
+}
@@ -1,5 +1,16 @@
 package filters
 
+import (
+	"encoding/base64"
+	"fmt"
+	"goleague/pkg/regions"
+	patchvalues "goleague/pkg/riotvalues/patch"
+	positionvalues "goleague/pkg/riotvalues/position"
+	"strconv"
+	"strings"
+	"time"
+)
+
 // URI params for the player endpoitns.
 type PlayerURIParams struct {
 	GameName string `uri:"gameName" binding:"required"`
@@ -30,51 +41,187 @@ func NewPlayerSearchFilter(qp PlayerSearchParams) *PlayerSearchFilter {
 	}
 }
 
+const (
+	// DefaultMatchHistoryPageSize is the amount of matches on each page without a page size.
+	DefaultMatchHistoryPageSize = 10
+	// MaxMatchHistoryPageSize caps the page size, bigger pages are reduced to it.
+	MaxMatchHistoryPageSize = 50
+)
+
 // Query params for the player match history.
+// The dates are inclusive days, like 2025-10-01.
+// The page is the zero based offset pagination of the older clients, the cursor is used over it.
 type PlayerMatchHistoryParams struct {
-	Page  int `form:"page"`
-	Queue int `form:"queue"`
+	Cursor   string    `form:"cursor"`
+	Page     int       `form:"page" binding:"min=0"`
+	PageSize int       `form:"pageSize" binding:"min=0"`
+	Queue    int       `form:"queue"`
+	Champion int       `form:"champion" binding:"min=0"`
+	Role     string    `form:"role"`
+	Result   string    `form:"result" binding:"omitempty,oneof=win loss"`
+	From     time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To       time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+	Patch    string    `form:"patch"`
+}
+
+// Validate checks the cursor, patch, role and period of the match history.
+func (qp PlayerMatchHistoryParams) Validate() error {
+	if qp.Cursor != "" {
+		if _, err := DecodeMatchHistoryCursor(qp.Cursor); err != nil {
+			return err
+		}
+	}
+
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRole(qp.Role); err != nil {
+		return err
+	}
+
+	if err := validateDateRange(qp.From, qp.To); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MatchHistoryCursor is the position of the last returned match, the next page starts after it.
+type MatchHistoryCursor struct {
+	MatchStart time.Time
+	MatchId    uint
+}
+
+// EncodeMatchHistoryCursor creates the opaque cursor sent to the clients.
+func EncodeMatchHistoryCursor(cursor MatchHistoryCursor) string {
+	raw := fmt.Sprintf("%s_%d", cursor.MatchStart.UTC().Format(time.RFC3339Nano), cursor.MatchId)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeMatchHistoryCursor parses a cursor created by EncodeMatchHistoryCursor.
+func DecodeMatchHistoryCursor(encoded string) (*MatchHistoryCursor, error) {
+	invalidCursor := fmt.Errorf("invalid cursor %s", encoded)
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	matchStart, matchId, found := strings.Cut(string(raw), "_")
+	if !found {
+		return nil, invalidCursor
+	}
+
+	start, err := time.Parse(time.RFC3339Nano, matchStart)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	id, err := strconv.ParseUint(matchId, 10, 0)
+	if err != nil {
+		return nil, invalidCursor
+	}
+
+	return &MatchHistoryCursor{MatchStart: start, MatchId: uint(id)}, nil
 }
 
 // PlayerMatchHistoryFilter is the full structure for a player matchHistoryFilter.
+// The zero values don't filter, the win is only set when filtering by the result.
 type PlayerMatchHistoryFilter struct {
-	GameName string
-	GameTag  string
-	Region   string
-	Page     int
-	PlayerId *uint
-	Queue    int
+	GameName   string
+	GameTag    string
+	Region     string
+	PlayerId   *uint
+	Queue      int
+	ChampionId int
+	Role       string
+	Win        *bool
+	Since      time.Time
+	Until      time.Time
+	Patch      string
+	PageSize   int
+	Page       int
+	Cursor     *MatchHistoryCursor
 }
 
+// NewPlayerMatchHistoryFilter creates the filter, the params must be validated first.
 func NewPlayerMatchHistoryFilter(qp PlayerMatchHistoryParams, pp *PlayerURIParams) *PlayerMatchHistoryFilter {
-	return &PlayerMatchHistoryFilter{
-		GameName: pp.GameName,
-		GameTag:  pp.GameTag,
-		Page:     qp.Page,
-		Queue:    qp.Queue,
-		Region:   pp.Region,
+	filters := &PlayerMatchHistoryFilter{
+		GameName:   pp.GameName,
+		GameTag:    pp.GameTag,
+		Region:     pp.Region,
+		Queue:      qp.Queue,
+		ChampionId: qp.Champion,
+		Role:       positionvalues.Normalize(qp.Role),
+		Since:      qp.From,
+		Patch:      qp.Patch,
+		PageSize:   DefaultMatchHistoryPageSize,
+	}
+
+	if qp.PageSize != 0 {
+		filters.PageSize = min(qp.PageSize, MaxMatchHistoryPageSize)
 	}
+
+	if qp.Result != "" {
+		win := qp.Result == "win"
+		filters.Win = &win
+	}
+
+	if !qp.To.IsZero() {
+		filters.Until = inclusiveEnd(qp.To)
+	}
+
+	if qp.Cursor != "" {
+		filters.Cursor, _ = DecodeMatchHistoryCursor(qp.Cursor)
+	} else {
+		filters.Page = qp.Page
+	}
+
+	return filters
 }
 
-// Query params for the player match history.
+// Query params for the player stats.
+// The interval is the amount of days of matches, up to 90.
 type PlayerStatsParams struct {
-	Interval int `form:"interval"`
+	Interval int    `form:"interval" binding:"min=0,max=90"`
+	Patch    string `form:"patch"`
 }
 
 // PlayerStatsFilter is the full stats filtering for player stats.
+// The since time is used over the interval when set, so other queries can share the same period.
 type PlayerStatsFilter struct {
 	GameName string
 	GameTag  string
 	Interval int
+	Since    time.Time
+	Patch    string
 	PlayerId *uint
 	Region   string
 }
 
+// Start returns the first match time of the stats, defaulting to the last DefaultStatsInterval days.
+func (f *PlayerStatsFilter) Start() time.Time {
+	if !f.Since.IsZero() {
+		return f.Since
+	}
+
+	interval := DefaultStatsInterval
+	if f.Interval != 0 {
+		interval = f.Interval
+	}
+
+	return time.Now().AddDate(0, 0, -interval)
+}
+
 func NewPlayerStatsFilter(qp PlayerStatsParams, pp *PlayerURIParams) *PlayerStatsFilter {
 	return &PlayerStatsFilter{
 		GameName: pp.GameName,
 		GameTag:  pp.GameTag,
 		Interval: qp.Interval,
+		Patch:    qp.Patch,
 		Region:   pp.Region,
 	}
 }
@@ -124,3 +271,246 @@ func NewPlayerInfoFilter(pp *PlayerURIParams) *PlayerInfoFilter {
 		Region:   pp.Region,
 	}
 }
+
+// DefaultRatingHistoryDays is how far back the rating history looks without a start date.
+const DefaultRatingHistoryDays = 30
+
+// Ranges longer than this many days return a single entry per day.
+const ratingHistoryFullDays = 31
+
+// Query params for the player rating history.
+// The dates are inclusive days, like 2025-10-01.
+type PlayerRatingHistoryParams struct {
+	Queue int       `form:"queue"`
+	From  time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To    time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+}
+
+// Validate checks if the queue has ratings and the period is in order.
+func (qp PlayerRatingHistoryParams) Validate() error {
+	if _, exists := ratingQueues[qp.Queue]; qp.Queue != 0 && !exists {
+		return fmt.Errorf("invalid queue %d, only the ranked queues have ratings", qp.Queue)
+	}
+
+	if err := validateDateRange(qp.From, qp.To); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PlayerRatingHistoryFilter is the rating history filter, with the queue as stored on the ratings.
+type PlayerRatingHistoryFilter struct {
+	GameName string
+	GameTag  string
+	Region   string
+	PlayerId *uint
+	Queue    string
+	Since    time.Time
+	Until    time.Time
+	Daily    bool
+}
+
+// NewPlayerRatingHistoryFilter creates the filter, defaulting to the ranked solo queue on the last days.
+func NewPlayerRatingHistoryFilter(qp PlayerRatingHistoryParams, pp *PlayerURIParams) *PlayerRatingHistoryFilter {
+	filters := &PlayerRatingHistoryFilter{
+		GameName: pp.GameName,
+		GameTag:  pp.GameTag,
+		Region:   pp.Region,
+		Queue:    ratingQueues[420],
+		Until:    time.Now().UTC(),
+	}
+
+	if qp.Queue != 0 {
+		filters.Queue = ratingQueues[qp.Queue]
+	}
+
+	if !qp.To.IsZero() {
+		filters.Until = inclusiveEnd(qp.To)
+	}
+
+	filters.Since = filters.Until.AddDate(0, 0, -DefaultRatingHistoryDays)
+	if !qp.From.IsZero() {
+		filters.Since = qp.From
+	}
+
+	filters.Daily = filters.Until.Sub(filters.Since) > ratingHistoryFullDays*24*time.Hour
+
+	return filters
+}
+
+// DefaultDuoDays is how far back the duo partners look without a window.
+const DefaultDuoDays = 30
+
+// Query params for the player duo partners.
+type PlayerDuoParams struct {
+	Days  int `form:"days" binding:"min=0,max=90"`
+	Queue int `form:"queue"`
+}
+
+// PlayerDuoFilter is the filter of the recent matches used for the duo partners.
+type PlayerDuoFilter struct {
+	GameName string
+	GameTag  string
+	Region   string
+	PlayerId *uint
+	Queue    int
+	Since    time.Time
+}
+
+// NewPlayerDuoFilter creates the filter, defaulting to every queue on the last days.
+func NewPlayerDuoFilter(qp PlayerDuoParams, pp *PlayerURIParams) *PlayerDuoFilter {
+	days := DefaultDuoDays
+	if qp.Days != 0 {
+		days = qp.Days
+	}
+
+	return &PlayerDuoFilter{
+		GameName: pp.GameName,
+		GameTag:  pp.GameTag,
+		Region:   pp.Region,
+		Queue:    qp.Queue,
+		Since:    time.Now().UTC().AddDate(0, 0, -days),
+	}
+}
+
+// DefaultSummaryMatches is the amount of recent matches summarized without a count.
+const DefaultSummaryMatches = 20
+
+// Query params for the player recent matches summary.
+type PlayerSummaryParams struct {
+	Count int `form:"count" binding:"min=0,max=50"`
+	Queue int `form:"queue"`
+}
+
+// PlayerSummaryFilter is the filter of the recent matches summary.
+type PlayerSummaryFilter struct {
+	GameName string
+	GameTag  string
+	Region   string
+	PlayerId *uint
+	Queue    int
+	Count    int
+}
+
+// NewPlayerSummaryFilter creates the filter, defaulting to the last matches of every queue.
+func NewPlayerSummaryFilter(qp PlayerSummaryParams, pp *PlayerURIParams) *PlayerSummaryFilter {
+	filters := &PlayerSummaryFilter{
+		GameName: pp.GameName,
+		GameTag:  pp.GameTag,
+		Region:   pp.Region,
+		Queue:    qp.Queue,
+		Count:    DefaultSummaryMatches,
+	}
+
+	if qp.Count != 0 {
+		filters.Count = qp.Count
+	}
+
+	return filters
+}
+
+// HistoryFilter returns the match history filter of the summarized matches.
+func (f *PlayerSummaryFilter) HistoryFilter() *PlayerMatchHistoryFilter {
+	return &PlayerMatchHistoryFilter{
+		GameName: f.GameName,
+		GameTag:  f.GameTag,
+		Region:   f.Region,
+		PlayerId: f.PlayerId,
+		Queue:    f.Queue,
+		PageSize: f.Count,
+	}
+}
+
+// DefaultStatsInterval is the amount of days of matches on the player stats without an interval.
+const DefaultStatsInterval = 30
+
+// Amount of players that can be compared at once.
+const (
+	minComparedPlayers = 2
+	maxComparedPlayers = 5
+)
+
+// Query params for the player comparison.
+// The players are separated by commas, each one as region/name/tag. The interval is up to 90 days, like the stats.
+type PlayerCompareParams struct {
+	Players  string `form:"players" binding:"required"`
+	Interval int    `form:"interval" binding:"min=0,max=90"`
+	Patch    string `form:"patch"`
+}
+
+// Validate checks the compared players and the patch.
+// The region of every player must be enabled on the region config.
+func (qp PlayerCompareParams) Validate(rc *regions.RegionConfig) error {
+	players, err := parseComparedPlayers(qp.Players)
+	if err != nil {
+		return err
+	}
+
+	if len(players) < minComparedPlayers || len(players) > maxComparedPlayers {
+		return fmt.Errorf("expected between %d and %d players, received %d", minComparedPlayers, maxComparedPlayers, len(players))
+	}
+
+	for _, player := range players {
+		if err := ValidateRegion(rc, player.Region); err != nil {
+			return err
+		}
+	}
+
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseComparedPlayers splits the players of the comparison, rejecting repeated players.
+func parseComparedPlayers(raw string) ([]PlayerURIParams, error) {
+	var players []PlayerURIParams
+	seen := make(map[string]bool)
+
+	for entry := range strings.SplitSeq(raw, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid player %s, expected region/name/tag", entry)
+		}
+
+		region := strings.ToUpper(parts[0])
+		key := strings.ToLower(strings.Join([]string{region, parts[1], parts[2]}, "/"))
+		if seen[key] {
+			return nil, fmt.Errorf("the player %s was repeated", entry)
+		}
+		seen[key] = true
+
+		players = append(players, PlayerURIParams{Region: region, GameName: parts[1], GameTag: parts[2]})
+	}
+
+	return players, nil
+}
+
+// PlayerCompareFilter is the filter of the player comparison, with the players on the requested order.
+type PlayerCompareFilter struct {
+	Players  []PlayerURIParams
+	Interval int
+	Patch    string
+	Since    time.Time
+}
+
+// NewPlayerCompareFilter creates the filter, the params must be validated first.
+func NewPlayerCompareFilter(qp PlayerCompareParams) *PlayerCompareFilter {
+	players, _ := parseComparedPlayers(qp.Players)
+
+	interval := DefaultStatsInterval
+	if qp.Interval != 0 {
+		interval = qp.Interval
+	}
+
+	return &PlayerCompareFilter{
+		Players:  players,
+		Interval: interval,
+		Patch:    qp.Patch,
+		Since:    time.Now().AddDate(0, 0, -interval),
+	}
+}
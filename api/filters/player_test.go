@@ -0,0 +1,84 @@
+package filters
+
+import (
+	"goleague/pkg/regions"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlayerCompareParamsValidate(t *testing.T) {
+	rc, err := regions.NewRegionConfig([]string{"BR1", "NA1"}, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		query         string
+		regions       *regions.RegionConfig
+		expectedError string
+	}{
+		{name: "enabledRegions", query: "players=br1/First/BR1,na1/Second/NA1", regions: rc},
+		{name: "disabledRegion", query: "players=br1/First/BR1,kr/Second/KR1", regions: rc, expectedError: "the region KR isn't supported"},
+		{name: "anyKnownRegionWithoutConfig", query: "players=br1/First/BR1,kr/Second/KR1"},
+		{name: "unknownRegionWithoutConfig", query: "players=br1/First/BR1,xx9/Second/XX9", expectedError: "XX9"},
+		{name: "singlePlayer", query: "players=br1/First/BR1", regions: rc, expectedError: "expected between 2 and 5 players"},
+		{name: "repeatedPlayer", query: "players=br1/First/BR1,BR1/first/br1", regions: rc, expectedError: "was repeated"},
+		{name: "maxInterval", query: "players=br1/First/BR1,na1/Second/NA1&interval=90", regions: rc},
+		{name: "tooLongInterval", query: "players=br1/First/BR1,na1/Second/NA1&interval=180", regions: rc, expectedError: "Interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var qp PlayerCompareParams
+			req := httptest.NewRequest("GET", "/player/compare?"+tt.query, nil)
+
+			err := binding.Query.Bind(req, &qp)
+			if err == nil {
+				err = qp.Validate(tt.regions)
+			}
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPlayerCompareFilterSince(t *testing.T) {
+	filters := NewPlayerCompareFilter(PlayerCompareParams{Players: "br1/First/BR1,na1/Second/NA1", Interval: 7})
+
+	// Every compared player and the shared matches use the same start.
+	expected := time.Now().AddDate(0, 0, -7)
+	assert.WithinDuration(t, expected, filters.Since, time.Minute)
+	assert.Equal(t, 7, filters.Interval)
+	assert.Equal(t, "NA1", filters.Players[1].Region)
+}
+
+func TestPlayerStatsFilterStart(t *testing.T) {
+	since := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		filters      *PlayerStatsFilter
+		expectedDays int
+	}{
+		{name: "defaultInterval", filters: &PlayerStatsFilter{}, expectedDays: DefaultStatsInterval},
+		{name: "interval", filters: &PlayerStatsFilter{Interval: 7}, expectedDays: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := time.Now().AddDate(0, 0, -tt.expectedDays)
+			assert.WithinDuration(t, expected, tt.filters.Start(), time.Minute)
+		})
+	}
+
+	assert.Equal(t, since, (&PlayerStatsFilter{Interval: 7, Since: since}).Start())
+}
@@ -1,15 +1,58 @@
 package filters
 
 import (
+	"goleague/pkg/regions"
+	patchvalues "goleague/pkg/riotvalues/patch"
+	positionvalues "goleague/pkg/riotvalues/position"
 	tiervalues "goleague/pkg/riotvalues/tier"
+	"strings"
+	"time"
 )
 
+// DefaultStatsDays is the window of days of the tierlist and champion statistics without a patch or start date.
+// Keeps the queries on the partitions of the last months.
+const DefaultStatsDays = 30
+
 // Query parameters for the tierlist filters.
+// The dates are inclusive days, like 2025-10-01.
+// Without a patch or start date, the window defaults to DefaultStatsDays.
 type TierlistQueryParams struct {
-	Tier      string `form:"tier"`
-	Rank      string `form:"rank"`
-	Queue     int    `form:"queue"`
-	AboveTier bool   `form:"above_tiers"`
+	Tier      string    `form:"tier"`
+	Rank      string    `form:"rank"`
+	Queue     int       `form:"queue"`
+	AboveTier bool      `form:"above_tiers"`
+	Patch     string    `form:"patch"`
+	Region    string    `form:"region"`
+	Role      string    `form:"role"`
+	From      time.Time `form:"from" time_format:"2006-01-02" time_utc:"1"`
+	To        time.Time `form:"to" time_format:"2006-01-02" time_utc:"1"`
+	MinGames  int       `form:"min_games" binding:"min=0"`
+	Days      int       `form:"days" binding:"min=0,max=90"`
+}
+
+// Validate checks the patch, role, region and period of the tierlist.
+func (qp TierlistQueryParams) Validate(rc *regions.RegionConfig) error {
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			return err
+		}
+	}
+
+	if err := validateRole(qp.Role); err != nil {
+		return err
+	}
+
+	if qp.Region != "" {
+		if err := ValidateRegion(rc, qp.Region); err != nil {
+			return err
+		}
+	}
+
+	if err := validateDateRange(qp.From, qp.To); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 type TierlistFilter struct {
@@ -18,12 +61,21 @@ type TierlistFilter struct {
 	NumericTier   int
 	Rank          *string
 	Queue         int
+	Patch         string
+	Region        string
+	Role          string
+	MinGames      int
+	Days          int
+	Since         time.Time
+	Until         time.Time
+	CustomPeriod  bool
 }
 
 func NewTierlistFilter(params TierlistQueryParams) *TierlistFilter {
 	filters := &TierlistFilter{
 		GetTiersAbove: false,
 		NumericTier:   0,
+		Days:          defaultStatsDays(params.Days, params.Patch, params.From),
 	}
 
 	// Only add non-empty filters.
@@ -52,5 +104,40 @@ func NewTierlistFilter(params TierlistQueryParams) *TierlistFilter {
 
 	filters.GetTiersAbove = params.AboveTier
 
+	filters.Region = strings.ToUpper(params.Region)
+	filters.Role = positionvalues.Normalize(params.Role)
+	filters.MinGames = params.MinGames
+
+	filters.Patch = params.Patch
+
+	if !params.To.IsZero() {
+		filters.CustomPeriod = true
+		filters.Until = inclusiveEnd(params.To)
+	}
+
+	// The window of days ends on the to date when there is one.
+	if filters.Days != 0 {
+		end := filters.Until
+		if end.IsZero() {
+			end = time.Now()
+		}
+		filters.Since = end.AddDate(0, 0, -filters.Days)
+	}
+
+	if !params.From.IsZero() {
+		filters.CustomPeriod = true
+		filters.Since = params.From
+	}
+
 	return filters
 }
+
+// defaultStatsDays returns the window of days of the statistics.
+// A patch or a start date already bounds the matches, so they don't get the default window.
+func defaultStatsDays(days int, patch string, from time.Time) int {
+	if days == 0 && patch == "" && from.IsZero() {
+		return DefaultStatsDays
+	}
+
+	return days
+}
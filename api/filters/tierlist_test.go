@@ -0,0 +1,98 @@
+package filters
+
+import (
+	"goleague/pkg/regions"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTierlistQueryParamsValidate(t *testing.T) {
+	rc, err := regions.NewRegionConfig([]string{"BR1", "NA1"}, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		query         string
+		regions       *regions.RegionConfig
+		expectedError string
+	}{
+		{name: "noFilters", query: "", regions: rc},
+		{name: "enabledRegion", query: "region=br1", regions: rc},
+		{name: "disabledRegion", query: "region=kr", regions: rc, expectedError: "the region kr isn't supported"},
+		{name: "unknownRegion", query: "region=xx9", regions: rc, expectedError: "xx9"},
+		{name: "anyKnownRegionWithoutConfig", query: "region=kr"},
+		{name: "unknownRegionWithoutConfig", query: "region=xx9", expectedError: "xx9"},
+		{name: "minGames", query: "min_games=20", regions: rc},
+		{name: "negativeMinGames", query: "min_games=-1", regions: rc, expectedError: "MinGames"},
+		{name: "period", query: "from=2025-10-01&to=2025-10-15", regions: rc},
+		{name: "singleDayPeriod", query: "from=2025-10-01&to=2025-10-01", regions: rc},
+		{name: "toBeforeFrom", query: "from=2025-10-15&to=2025-10-01", regions: rc, expectedError: "the to date can't be before the from date"},
+		{name: "invalidDate", query: "from=15-10-2025", regions: rc, expectedError: "parsing time"},
+		{name: "tooManyDays", query: "days=91", regions: rc, expectedError: "Days"},
+		{name: "invalidRole", query: "role=carry", regions: rc, expectedError: "invalid role carry"},
+		{name: "invalidPatch", query: "patch=15.19.1", regions: rc, expectedError: "invalid patch"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var qp TierlistQueryParams
+			req := httptest.NewRequest("GET", "/tierlist?"+tt.query, nil)
+
+			err := binding.Query.Bind(req, &qp)
+			if err == nil {
+				err = qp.Validate(tt.regions)
+			}
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewTierlistFilterPeriod(t *testing.T) {
+	from := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		params        TierlistQueryParams
+		expectedSince time.Time
+		expectedUntil time.Time
+		customPeriod  bool
+	}{
+		{name: "patchWithoutPeriod", params: TierlistQueryParams{Patch: "15.19"}},
+		{name: "inclusiveTo", params: TierlistQueryParams{To: to}, expectedSince: to.AddDate(0, 0, 1-DefaultStatsDays), expectedUntil: to.AddDate(0, 0, 1), customPeriod: true},
+		{name: "patchWithTo", params: TierlistQueryParams{Patch: "15.19", To: to}, expectedUntil: to.AddDate(0, 0, 1), customPeriod: true},
+		{name: "fromAndTo", params: TierlistQueryParams{From: from, To: to}, expectedSince: from, expectedUntil: to.AddDate(0, 0, 1), customPeriod: true},
+		{name: "daysEndingOnTo", params: TierlistQueryParams{To: to, Days: 7}, expectedSince: to.AddDate(0, 0, -6), expectedUntil: to.AddDate(0, 0, 1), customPeriod: true},
+		{name: "fromOverDays", params: TierlistQueryParams{From: from, To: to, Days: 7}, expectedSince: from, expectedUntil: to.AddDate(0, 0, 1), customPeriod: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters := NewTierlistFilter(tt.params)
+
+			assert.Equal(t, tt.expectedSince, filters.Since)
+			assert.Equal(t, tt.expectedUntil, filters.Until)
+			assert.Equal(t, tt.customPeriod, filters.CustomPeriod)
+		})
+	}
+}
+
+func TestNewTierlistFilterDefaultDays(t *testing.T) {
+	filters := NewTierlistFilter(TierlistQueryParams{})
+
+	assert.Equal(t, DefaultStatsDays, filters.Days)
+	assert.WithinDuration(t, time.Now().AddDate(0, 0, -DefaultStatsDays), filters.Since, time.Minute)
+	assert.True(t, filters.Until.IsZero())
+	assert.False(t, filters.CustomPeriod)
+}
@@ -0,0 +1,53 @@
+package filters
+
+import (
+	"errors"
+	"fmt"
+	"goleague/pkg/messages"
+	"goleague/pkg/regions"
+	positionvalues "goleague/pkg/riotvalues/position"
+	"strings"
+	"time"
+)
+
+// ValidateRegion checks if a sub region is enabled in this deployment.
+// Without a region config, any region known by the Riot API is accepted.
+func ValidateRegion(rc *regions.RegionConfig, region string) error {
+	subRegion := regions.SubRegion(strings.ToUpper(region))
+
+	if rc == nil {
+		if _, ok := regions.GetParentRegion(subRegion); !ok {
+			return fmt.Errorf(messages.UnsupportedRegion, region)
+		}
+		return nil
+	}
+
+	if !rc.IsEnabled(subRegion) {
+		return fmt.Errorf(messages.UnsupportedRegion, region)
+	}
+
+	return nil
+}
+
+// validateRole checks if an optional role is one of the team positions.
+func validateRole(role string) error {
+	if role != "" && positionvalues.Normalize(role) == "" {
+		return fmt.Errorf("invalid role %s, expected one of %s", role, strings.Join(positionvalues.TeamPositions, ", "))
+	}
+
+	return nil
+}
+
+// validateDateRange checks if the period is in order, any of the dates can be empty.
+func validateDateRange(from time.Time, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return errors.New("the to date can't be before the from date")
+	}
+
+	return nil
+}
+
+// inclusiveEnd returns the exclusive bound of an inclusive to date, the start of the next day.
+func inclusiveEnd(to time.Time) time.Time {
+	return to.AddDate(0, 0, 1)
+}
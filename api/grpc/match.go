@@ -0,0 +1,49 @@
+package grpcclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "goleague/pkg/grpc"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Fetching a timeline goes through the Riot API and the insertion of every event.
+const timelineGRPCCallTimeout = time.Second * 15
+
+// MatchGRPCClient is a interface for any match related gRPC client fetching.
+type MatchGRPCClient interface {
+	FetchMatchTimeline(ctx context.Context, matchId string) (*pb.MatchTimelineNotification, error)
+}
+
+type matchGRPCClient struct {
+	*grpc.ClientConn
+}
+
+// NewMatchGRPCClient creates a new match gRPC client.
+func NewMatchGRPCClient(grpcConn *grpc.ClientConn) MatchGRPCClient {
+	return &matchGRPCClient{ClientConn: grpcConn}
+}
+
+// FetchMatchTimeline makes a gRPC request to the fetcher to get the deferred timeline of a match.
+func (mgc *matchGRPCClient) FetchMatchTimeline(ctx context.Context, matchId string) (*pb.MatchTimelineNotification, error) {
+	client := pb.NewServiceClient(mgc.ClientConn)
+
+	ctx, cancel := context.WithTimeout(ctx, timelineGRPCCallTimeout)
+	defer cancel()
+
+	resp, err := client.FetchMatchTimeline(ctx, &pb.MatchTimelineRequest{MatchId: matchId})
+	if err != nil {
+		st, ok := status.FromError(err)
+		if ok {
+			return nil, fmt.Errorf("couldn't fetch the match timeline: %w", errors.New(st.Message()))
+		}
+		return nil, fmt.Errorf("couldn't fetch the match timeline: %w", err)
+	}
+
+	return resp, nil
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"goleague/api/filters"
 	championservice "goleague/api/services/champion"
+	"goleague/pkg/regions"
+	patchvalues "goleague/pkg/riotvalues/patch"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,16 +13,19 @@ import (
 // PlayerHandler is the handler for the player endpoints.
 type ChampionHandler struct {
 	ChampionService *championservice.ChampionService
+	regions         *regions.RegionConfig
 }
 
 type ChampionHandlerDependencies struct {
 	ChampionService *championservice.ChampionService
+	Regions         *regions.RegionConfig
 }
 
 // NewChampionHandler  creates a new instance of the champion handler.
 func NewChampionHandler(deps *ChampionHandlerDependencies) *ChampionHandler {
 	return &ChampionHandler{
 		ChampionService: deps.ChampionService,
+		regions:         deps.Regions,
 	}
 }
 
@@ -35,6 +40,19 @@ func (h *ChampionHandler) bindURIParams(c *gin.Context) (*filters.ChampionURIPar
 
 // GetChampionData is the handler to return all available data for a given champion.
 func (h *ChampionHandler) GetChampionData(c *gin.Context) {
+	var qp filters.ChampionQueryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
 
 	pp, err := h.bindURIParams(c)
 	if err != nil {
@@ -42,7 +60,7 @@ func (h *ChampionHandler) GetChampionData(c *gin.Context) {
 		return
 	}
 
-	filters := filters.NewGetChampionDataFilter(pp)
+	filters := filters.NewGetChampionDataFilter(pp, qp)
 
 	championData, err := h.ChampionService.GetChampionData(c, filters)
 	if err != nil {
@@ -53,6 +71,130 @@ func (h *ChampionHandler) GetChampionData(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"result": championData})
 }
 
+// GetChampionBuilds is the handler to return the most common and best performing items of a champion.
+func (h *ChampionHandler) GetChampionBuilds(c *gin.Context) {
+	var qp filters.ChampionStatsQueryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewChampionStatsFilter(pp, qp)
+
+	builds, err := h.ChampionService.GetChampionBuilds(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": builds})
+}
+
+// GetChampionSkills is the handler to return the most common and best performing skill orders of a champion.
+func (h *ChampionHandler) GetChampionSkills(c *gin.Context) {
+	var qp filters.ChampionStatsQueryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewChampionStatsFilter(pp, qp)
+
+	skills, err := h.ChampionService.GetChampionSkills(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": skills})
+}
+
+// GetChampionMatchups is the handler to return the performance of a champion against each lane opponent.
+func (h *ChampionHandler) GetChampionMatchups(c *gin.Context) {
+	var qp filters.ChampionStatsQueryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewChampionStatsFilter(pp, qp)
+
+	matchups, err := h.ChampionService.GetChampionMatchups(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": matchups})
+}
+
+// GetChampionSynergies is the handler to return the allies of a champion, with the tierlist filters.
+func (h *ChampionHandler) GetChampionSynergies(c *gin.Context) {
+	var qp filters.TierlistQueryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(h.regions); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewTierlistFilter(qp)
+
+	synergies, err := h.ChampionService.GetChampionSynergies(c, pp.ChampionId, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": synergies})
+}
+
 // GetAllChampions is the handler to return all available data for all champions.
 func (h *ChampionHandler) GetAllChampions(c *gin.Context) {
 	championData, err := h.ChampionService.GetAllChampions(c)
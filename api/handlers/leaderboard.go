@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"goleague/api/filters"
+	leaderboardservice "goleague/api/services/leaderboard"
+	"goleague/pkg/regions"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LeaderboardHandler is the handler for the leaderboard endpoints.
+type LeaderboardHandler struct {
+	leaderboardService *leaderboardservice.LeaderboardService
+	regions            *regions.RegionConfig
+}
+
+type LeaderboardHandlerDependencies struct {
+	LeaderboardService *leaderboardservice.LeaderboardService
+	Regions            *regions.RegionConfig
+}
+
+// NewLeaderboardHandler creates a new instance of the leaderboard handler.
+func NewLeaderboardHandler(deps *LeaderboardHandlerDependencies) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		leaderboardService: deps.LeaderboardService,
+		regions:            deps.Regions,
+	}
+}
+
+// GetLeaderboard returns a page of the ladder of a region.
+func (h *LeaderboardHandler) GetLeaderboard(c *gin.Context) {
+	var pp filters.LeaderboardURIParams
+	if err := c.ShouldBindUri(&pp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var qp filters.LeaderboardQueryParams
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(&pp, h.regions); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewLeaderboardFilter(&pp, qp)
+
+	result, err := h.leaderboardService.GetLeaderboard(c, filters)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": result})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	patchservice "goleague/api/services/patch"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PatchHandler is the handler for the patch endpoints.
+type PatchHandler struct {
+	patchService *patchservice.PatchService
+}
+
+type PatchHandlerDependencies struct {
+	PatchService *patchservice.PatchService
+}
+
+// NewPatchHandler creates a new instance of the patch handler.
+func NewPatchHandler(deps *PatchHandlerDependencies) *PatchHandler {
+	return &PatchHandler{
+		patchService: deps.PatchService,
+	}
+}
+
+// GetPatches returns the patches that have stored matches.
+func (h *PatchHandler) GetPatches(c *gin.Context) {
+	result, err := h.patchService.GetPatches(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": result})
+}
@@ -3,6 +3,8 @@ package handlers
 import (
 	"goleague/api/filters"
 	playerservice "goleague/api/services/player"
+	"goleague/pkg/regions"
+	patchvalues "goleague/pkg/riotvalues/patch"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,16 +13,19 @@ import (
 // PlayerHandler is the handler for the player endpoints.
 type PlayerHandler struct {
 	playerService *playerservice.PlayerService
+	regions       *regions.RegionConfig
 }
 
 type PlayerHandlerDependencies struct {
 	PlayerService *playerservice.PlayerService
+	Regions       *regions.RegionConfig
 }
 
 // NewPlayerHandler creates a new instance of the player handler.
 func NewPlayerHandler(deps *PlayerHandlerDependencies) *PlayerHandler {
 	return &PlayerHandler{
 		playerService: deps.PlayerService,
+		regions:       deps.Regions,
 	}
 }
 
@@ -30,9 +35,19 @@ func (h *PlayerHandler) bindURIParams(c *gin.Context) (*filters.PlayerURIParams,
 	if err := c.ShouldBindUri(&pp); err != nil {
 		return nil, err
 	}
+
+	if err := h.validateRegion(pp.Region); err != nil {
+		return nil, err
+	}
+
 	return &pp, nil
 }
 
+// Helper to validate if a region is enabled in this deployment.
+func (h *PlayerHandler) validateRegion(region string) error {
+	return filters.ValidateRegion(h.regions, region)
+}
+
 // ForceFetchPlayer calls the Fetcher service via gRPC to save a given player in the database.
 func (h *PlayerHandler) ForceFetchPlayer(c *gin.Context) {
 	// Path params.
@@ -82,6 +97,14 @@ func (h *PlayerHandler) GetPlayerSearch(c *gin.Context) {
 		return
 	}
 
+	// The region is optional for searching.
+	if qp.Region != "" {
+		if err := h.validateRegion(qp.Region); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	filters := filters.NewPlayerSearchFilter(qp)
 
 	result, err := h.playerService.GetPlayerSearch(c, filters)
@@ -101,6 +124,11 @@ func (h *PlayerHandler) GetPlayerMatchHistory(c *gin.Context) {
 		return
 	}
 
+	if err := qp.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Path params.
 	pp, err := h.bindURIParams(c)
 	if err != nil {
@@ -110,13 +138,14 @@ func (h *PlayerHandler) GetPlayerMatchHistory(c *gin.Context) {
 
 	filters := filters.NewPlayerMatchHistoryFilter(qp, pp)
 
-	matchList, err := h.playerService.GetPlayerMatchHistory(c, filters)
+	page, err := h.playerService.GetPlayerMatchHistory(c, filters)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"result": matchList})
+	// The result keeps the previews by the match id, the ids have the order of the matches.
+	c.JSON(http.StatusOK, gin.H{"result": page.Matches, "ids": page.Ids, "nextCursor": page.NextCursor})
 }
 
 // GetPlayerStats handles requests for retrieving a player average status.
@@ -128,6 +157,13 @@ func (h *PlayerHandler) GetPlayerStats(c *gin.Context) {
 		return
 	}
 
+	if qp.Patch != "" {
+		if err := patchvalues.Validate(qp.Patch); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
 	// Path params.
 	pp, err := h.bindURIParams(c)
 	if err != nil {
@@ -146,6 +182,117 @@ func (h *PlayerHandler) GetPlayerStats(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"result": playerStats})
 }
 
+// GetPlayerComparison handles requests for comparing players side by side.
+func (h *PlayerHandler) GetPlayerComparison(c *gin.Context) {
+	var qp filters.PlayerCompareParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(h.regions); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewPlayerCompareFilter(qp)
+
+	comparison, err := h.playerService.GetPlayerComparison(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": comparison})
+}
+
+// GetPlayerDuoPartners handles requests for the players that most often queue with a player.
+func (h *PlayerHandler) GetPlayerDuoPartners(c *gin.Context) {
+	var qp filters.PlayerDuoParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Path params.
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewPlayerDuoFilter(qp, pp)
+
+	partners, err := h.playerService.GetPlayerDuoPartners(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": partners})
+}
+
+// GetPlayerSummary handles requests for the summary of the recent matches of a player.
+func (h *PlayerHandler) GetPlayerSummary(c *gin.Context) {
+	var qp filters.PlayerSummaryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Path params.
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewPlayerSummaryFilter(qp, pp)
+
+	summary, err := h.playerService.GetPlayerSummary(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": summary})
+}
+
+// GetPlayerRatingHistory handles requests for the rating timeline of a player.
+func (h *PlayerHandler) GetPlayerRatingHistory(c *gin.Context) {
+	var qp filters.PlayerRatingHistoryParams
+
+	if err := c.ShouldBindQuery(&qp); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := qp.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Path params.
+	pp, err := h.bindURIParams(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	filters := filters.NewPlayerRatingHistoryFilter(qp, pp)
+
+	history, err := h.playerService.GetPlayerRatingHistory(c, filters)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": history})
+}
+
 // GetPlayerInfo handles getting all the player related data.
 func (h *PlayerHandler) GetPlayerInfo(c *gin.Context) {
 	// Path params.
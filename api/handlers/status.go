@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	statusservice "goleague/api/services/status"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StatusHandler is the handler for the fetcher status endpoints.
+type StatusHandler struct {
+	statusService *statusservice.StatusService
+}
+
+type StatusHandlerDependencies struct {
+	StatusService *statusservice.StatusService
+}
+
+// NewStatusHandler creates a new instance of the status handler.
+func NewStatusHandler(deps *StatusHandlerDependencies) *StatusHandler {
+	return &StatusHandler{
+		statusService: deps.StatusService,
+	}
+}
+
+// GetCrawlStatus returns the league crawl progress of each sub region.
+func (h *StatusHandler) GetCrawlStatus(c *gin.Context) {
+	result, err := h.statusService.GetCrawlStatus(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": result})
+}
+
+// GetFailedMatches returns the top reasons of the matches that couldn't be ingested.
+func (h *StatusHandler) GetFailedMatches(c *gin.Context) {
+	result, err := h.statusService.GetFailureReport(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"result": result})
+}
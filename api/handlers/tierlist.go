@@ -3,6 +3,7 @@ package handlers
 import (
 	"goleague/api/filters"
 	tierlistservice "goleague/api/services/tierlist"
+	"goleague/pkg/regions"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,16 +12,19 @@ import (
 // Tier list handler.
 type TierlistHandler struct {
 	tierlistService *tierlistservice.TierlistService
+	regions         *regions.RegionConfig
 }
 
 type TierlistHandlerDependencies struct {
 	TierlistService *tierlistservice.TierlistService
+	Regions         *regions.RegionConfig
 }
 
 // Create a new instance of the tierlist handler.
 func NewTierlistHandler(deps *TierlistHandlerDependencies) *TierlistHandler {
 	return &TierlistHandler{
 		tierlistService: deps.TierlistService,
+		regions:         deps.Regions,
 	}
 }
 
@@ -33,6 +37,11 @@ func (h *TierlistHandler) GetTierlist(c *gin.Context) {
 		return
 	}
 
+	if err := qp.Validate(h.regions); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	filters := filters.NewTierlistFilter(qp)
 
 	result, err := h.tierlistService.GetTierlist(c, filters)
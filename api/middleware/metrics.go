@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"goleague/pkg/metrics"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Metrics records the latency of each request by route template.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// Use the route template to avoid a label per player or match.
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		metrics.ObserveSince(
+			metrics.APIRequestDuration.WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())),
+			start,
+		)
+	}
+}
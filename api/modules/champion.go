@@ -7,15 +7,23 @@ import (
 
 func initializeChampionHandler(deps *ModuleDependencies) *handlers.ChampionHandler {
 	championDeps := &championservice.ChampionServiceDeps{
-		DB:            deps.DB,
-		ChampionCache: deps.ChampionCache,
-		MemCache:      deps.ChampionMemCache,
+		DB:                deps.DB,
+		ChampionCache:     deps.ChampionCache,
+		ItemCache:         deps.ItemCache,
+		MemCache:          deps.ChampionMemCache,
+		RoleStatsMemCache: deps.RoleStatsMemCache,
+		BuildsMemCache:    deps.BuildsMemCache,
+		SkillsMemCache:    deps.SkillsMemCache,
+		MatchupsMemCache:  deps.MatchupsMemCache,
+		SynergiesMemCache: deps.SynergiesMemCache,
+		Redis:             deps.Redis,
 	}
 
 	championService := championservice.NewChampionService(championDeps)
 
 	championHandlerDeps := &handlers.ChampionHandlerDependencies{
 		ChampionService: championService,
+		Regions:         deps.Regions,
 	}
 
 	return handlers.NewChampionHandler(championHandlerDeps)
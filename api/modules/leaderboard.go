@@ -0,0 +1,21 @@
+package modules
+
+import (
+	"goleague/api/handlers"
+	leaderboardservice "goleague/api/services/leaderboard"
+)
+
+func initializeLeaderboardHandler(deps *ModuleDependencies) *handlers.LeaderboardHandler {
+	leaderboardDeps := &leaderboardservice.LeaderboardServiceDeps{
+		DB: deps.DB,
+	}
+
+	leaderboardService := leaderboardservice.NewLeaderboardService(leaderboardDeps)
+
+	leaderboardHandlerDeps := &handlers.LeaderboardHandlerDependencies{
+		LeaderboardService: leaderboardService,
+		Regions:            deps.Regions,
+	}
+
+	return handlers.NewLeaderboardHandler(leaderboardHandlerDeps)
+}
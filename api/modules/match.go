@@ -1,13 +1,16 @@
 package modules
 
 import (
+	grpcclient "goleague/api/grpc"
 	"goleague/api/handlers"
 	matchservice "goleague/api/services/match"
 )
 
 func initializeMatchHandler(deps *ModuleDependencies) *handlers.MatchHandler {
 	matchDeps := &matchservice.MatchServiceDeps{
-		DB: deps.DB,
+		DB:         deps.DB,
+		GrpcClient: grpcclient.NewMatchGRPCClient(deps.GrpcClient),
+		Redis:      deps.Redis,
 	}
 
 	matchService := matchservice.NewMatchService(matchDeps)
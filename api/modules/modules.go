@@ -4,8 +4,11 @@ import (
 	"goleague/api/cache"
 	"goleague/api/dto"
 	"goleague/api/handlers"
+	"goleague/api/middleware"
+	"goleague/pkg/logger"
 	"goleague/pkg/models/champion"
 	"goleague/pkg/redis"
+	"goleague/pkg/regions"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/grpc"
@@ -14,11 +17,14 @@ import (
 
 // Module containing the necessary handlers.
 type Module struct {
-	Router          *gin.Engine
-	ChampionHandler *handlers.ChampionHandler
-	MatchHandler    *handlers.MatchHandler
-	PlayerHandler   *handlers.PlayerHandler
-	TierlistHandler *handlers.TierlistHandler
+	Router             *gin.Engine
+	ChampionHandler    *handlers.ChampionHandler
+	LeaderboardHandler *handlers.LeaderboardHandler
+	MatchHandler       *handlers.MatchHandler
+	PatchHandler       *handlers.PatchHandler
+	PlayerHandler      *handlers.PlayerHandler
+	StatusHandler      *handlers.StatusHandler
+	TierlistHandler    *handlers.TierlistHandler
 }
 
 // ModuleDependencies holds the necessary dependencies to the module start.
@@ -27,20 +33,35 @@ type ModuleDependencies struct {
 	ChampionCache    cache.ChampionCache
 	GrpcClient       *grpc.ClientConn
 	ChampionMemCache cache.MemCache[*champion.Champion]
+	ItemCache        cache.ItemCache
 	TierlistMemCache cache.MemCache[[]*dto.TierlistResult]
+	Logger           *logger.Logger
 	Redis            *redis.RedisClient
+	Regions          *regions.RegionConfig
+
+	// Champion statistics memory caches.
+	RoleStatsMemCache cache.MemCache[[]*dto.ChampionRoleStats]
+	BuildsMemCache    cache.MemCache[*dto.ChampionBuilds]
+	SkillsMemCache    cache.MemCache[*dto.ChampionSkills]
+	MatchupsMemCache  cache.MemCache[*dto.ChampionMatchups]
+	SynergiesMemCache cache.MemCache[*dto.ChampionSynergies]
 }
 
 // Create a new module with all the necessary handlers initialized.
 func NewModule(deps *ModuleDependencies) (*Module, error) {
-	router := gin.Default()
+	// Same as the default engine, but with the structured request logs.
+	router := gin.New()
+	router.Use(middleware.Logger(deps.Logger.Logger), gin.Recovery())
 
 	// Return the module with all handlers.
 	return &Module{
-		Router:          router,
-		ChampionHandler: initializeChampionHandler(deps),
-		MatchHandler:    initializeMatchHandler(deps),
-		PlayerHandler:   initializePlayerHandler(deps),
-		TierlistHandler: initializeTierlistHandler(deps),
+		Router:             router,
+		ChampionHandler:    initializeChampionHandler(deps),
+		LeaderboardHandler: initializeLeaderboardHandler(deps),
+		MatchHandler:       initializeMatchHandler(deps),
+		PatchHandler:       initializePatchHandler(deps),
+		PlayerHandler:      initializePlayerHandler(deps),
+		StatusHandler:      initializeStatusHandler(deps),
+		TierlistHandler:    initializeTierlistHandler(deps),
 	}, nil
 }
@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"goleague/api/handlers"
+	patchservice "goleague/api/services/patch"
+)
+
+func initializePatchHandler(deps *ModuleDependencies) *handlers.PatchHandler {
+	patchDeps := &patchservice.PatchServiceDeps{
+		DB: deps.DB,
+	}
+
+	patchService := patchservice.NewPatchService(patchDeps)
+
+	patchHandlerDeps := &handlers.PatchHandlerDependencies{
+		PatchService: patchService,
+	}
+
+	return handlers.NewPatchHandler(patchHandlerDeps)
+}
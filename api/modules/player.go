@@ -23,6 +23,7 @@ func initializePlayerHandler(deps *ModuleDependencies) *handlers.PlayerHandler {
 
 	playerHandlerDeps := &handlers.PlayerHandlerDependencies{
 		PlayerService: playerService,
+		Regions:       deps.Regions,
 	}
 
 	return handlers.NewPlayerHandler(playerHandlerDeps)
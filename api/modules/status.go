@@ -0,0 +1,20 @@
+package modules
+
+import (
+	"goleague/api/handlers"
+	statusservice "goleague/api/services/status"
+)
+
+func initializeStatusHandler(deps *ModuleDependencies) *handlers.StatusHandler {
+	statusDeps := &statusservice.StatusServiceDeps{
+		DB: deps.DB,
+	}
+
+	statusService := statusservice.NewStatusService(statusDeps)
+
+	statusHandlerDeps := &handlers.StatusHandlerDependencies{
+		StatusService: statusService,
+	}
+
+	return handlers.NewStatusHandler(statusHandlerDeps)
+}
@@ -17,6 +17,7 @@ func initializeTierlistHandler(deps *ModuleDependencies) *handlers.TierlistHandl
 
 	tierlistHandlerDeps := &handlers.TierlistHandlerDependencies{
 		TierlistService: tierlistService,
+		Regions:         deps.Regions,
 	}
 
 	return handlers.NewTierlistHandler(tierlistHandlerDeps)
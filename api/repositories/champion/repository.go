@@ -0,0 +1,405 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"goleague/api/filters"
+	"goleague/pkg/database"
+	tiervalues "goleague/pkg/riotvalues/tier"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Pairs below this many matches are hidden when no minimum is requested.
+const defaultSynergyMinGames = 10
+
+// ChampionRepository is the public interface for accessing the champion statistics.
+type ChampionRepository interface {
+	GetChampionItemEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionItemEvent, error)
+	GetChampionMatchups(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionMatchupStats, error)
+	GetChampionSkillEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionSkillEvent, error)
+	GetChampionStats(ctx context.Context, championKey int, filters *filters.GetChampionDataFilter) ([]*ChampionRoleStats, error)
+	GetChampionSynergies(ctx context.Context, championKey int, filters *filters.TierlistFilter) ([]*ChampionSynergyStats, error)
+}
+
+// ChampionItemEvent is a purchase, or the undo of one, by a player of the champion.
+type ChampionItemEvent struct {
+	MatchStatId uint64
+	Win         bool
+	ItemId      int
+	Action      string
+	Timestamp   int64
+}
+
+// ChampionMatchupStats is the performance of a champion against a lane opponent.
+// The differences are from the champion side, null when no match reached the minute.
+type ChampionMatchupStats struct {
+	TeamPosition string
+	OpponentKey  int
+	Matches      int64
+	WinRate      float64
+	GoldDiffAt10 *float64
+	XpDiffAt10   *float64
+	CsDiffAt10   *float64
+	GoldDiffAt15 *float64
+	XpDiffAt15   *float64
+	CsDiffAt15   *float64
+}
+
+// ChampionSynergyStats is the performance of a champion with an ally.
+// The win rates of both champions alone, on the same positions, are the base of the expected win rate.
+type ChampionSynergyStats struct {
+	TeamPosition    string
+	AllyKey         int
+	AllyPosition    string
+	Matches         int64
+	WinRate         float64
+	ChampionWinRate float64
+	AllyWinRate     float64
+}
+
+// ChampionSkillEvent is a skill point spent by a player of the champion.
+type ChampionSkillEvent struct {
+	MatchStatId uint64
+	Win         bool
+	SkillSlot   int
+	Timestamp   int64
+}
+
+// ChampionRoleStats is the performance of a champion on a given position.
+type ChampionRoleStats struct {
+	Matches      int64
+	TeamPosition string
+	WinRate      float64
+}
+
+// championRepository repository structure.
+type championRepository struct {
+	db *gorm.DB
+}
+
+// NewChampionRepository creates a champion repository.
+func NewChampionRepository(db *gorm.DB) ChampionRepository {
+	return &championRepository{db: db}
+}
+
+// GetChampionStats returns the matches and win rate of a champion by position, most played first.
+// The champion key is the numeric id used on the match stats.
+func (cr *championRepository) GetChampionStats(ctx context.Context, championKey int, filters *filters.GetChampionDataFilter) ([]*ChampionRoleStats, error) {
+	var stats []*ChampionRoleStats
+
+	query := cr.db.WithContext(ctx).
+		Table("match_stats ms").
+		Select(`
+			ms.team_position,
+			COUNT(*) AS matches,
+			ROUND(AVG(ms.win::int) * 100, 2) AS win_rate
+		`).
+		Joins("JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start").
+		Where("ms.champion_id = ?", championKey).
+		Where("mi.queue_id = ?", filters.Queue)
+
+	if filters.Patch != "" {
+		query = query.Where("mi.patch = ?", filters.Patch)
+	}
+
+	// Bound both tables, so only the partitions of the period are scanned.
+	if !filters.Since.IsZero() {
+		query = query.Where("mi.match_start >= ? AND ms.match_start >= ?", filters.Since, filters.Since)
+	}
+
+	if err := query.
+		Group("ms.team_position").
+		Order("matches DESC").
+		Scan(&stats).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetChampionItemEvents returns the purchases and undos of the champion players, ordered by player and time.
+// The champion key is the numeric id used on the match stats.
+func (cr *championRepository) GetChampionItemEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionItemEvent, error) {
+	var events []*ChampionItemEvent
+
+	query := cr.db.WithContext(ctx).
+		Table("match_stats ms").
+		Select(`
+			ms.id AS match_stat_id,
+			ms.win,
+			ei.item_id,
+			ei.action,
+			ei.timestamp
+		`).
+		Joins("JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start").
+		Joins(`
+			JOIN event_items ei
+			ON ei.match_id = ms.match_id
+			AND ei.participant_id = ms.participant_id
+			AND ei.match_start = ms.match_start
+		`).
+		Where("ei.action IN ?", []string{"ITEM_PURCHASED", "ITEM_UNDO"})
+
+	if err := applyStatsFilter(query, championKey, filters, "ei").
+		Order("ms.id, ei.timestamp").
+		Scan(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetChampionSkillEvents returns the skill points of the champion players, ordered by player and time.
+// Only the regular level ups are returned, the evolutions don't spend points.
+func (cr *championRepository) GetChampionSkillEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionSkillEvent, error) {
+	var events []*ChampionSkillEvent
+
+	query := cr.db.WithContext(ctx).
+		Table("match_stats ms").
+		Select(`
+			ms.id AS match_stat_id,
+			ms.win,
+			es.skill_slot,
+			es.timestamp
+		`).
+		Joins("JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start").
+		Joins(`
+			JOIN event_skill_level_ups es
+			ON es.match_id = ms.match_id
+			AND es.participant_id = ms.participant_id
+			AND es.match_start = ms.match_start
+		`).
+		Where("es.level_up_type = ?", "NORMAL")
+
+	if err := applyStatsFilter(query, championKey, filters, "es").
+		Order("ms.id, es.timestamp").
+		Scan(&events).Error; err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// GetChampionMatchups returns the matches, win rate and early differences against each opponent on the same position.
+// The frames at 10 and 15 minutes are found by the frame interval of the match, usually one minute.
+func (cr *championRepository) GetChampionMatchups(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*ChampionMatchupStats, error) {
+	var matchups []*ChampionMatchupStats
+
+	query := cr.db.WithContext(ctx).
+		Table("match_stats ms").
+		Select(`
+			ms.team_position,
+			opp.champion_id AS opponent_key,
+			COUNT(*) AS matches,
+			ROUND(AVG(ms.win::int) * 100, 2) AS win_rate,
+			ROUND(AVG(pf10.total_gold - opf10.total_gold), 2) AS gold_diff_at10,
+			ROUND(AVG(pf10.xp - opf10.xp), 2) AS xp_diff_at10,
+			ROUND(AVG(
+				(pf10.minions_killed + pf10.jungle_minions_killed) -
+				(opf10.minions_killed + opf10.jungle_minions_killed)
+			), 2) AS cs_diff_at10,
+			ROUND(AVG(pf15.total_gold - opf15.total_gold), 2) AS gold_diff_at15,
+			ROUND(AVG(pf15.xp - opf15.xp), 2) AS xp_diff_at15,
+			ROUND(AVG(
+				(pf15.minions_killed + pf15.jungle_minions_killed) -
+				(opf15.minions_killed + opf15.jungle_minions_killed)
+			), 2) AS cs_diff_at15
+		`).
+		Joins("JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start").
+		Joins(`
+			JOIN match_stats opp
+			ON opp.match_id = ms.match_id
+			AND opp.match_start = ms.match_start
+			AND opp.team_position = ms.team_position
+			AND opp.team_id <> ms.team_id
+		`).
+		Joins(database.FrameJoin("pf10", "ms", 10)).
+		Joins(database.FrameJoin("opf10", "opp", 10)).
+		Joins(database.FrameJoin("pf15", "ms", 15)).
+		Joins(database.FrameJoin("opf15", "opp", 15)).
+		Where("ms.team_position <> ''")
+
+	if err := applyStatsFilter(query, championKey, filters, "opp").
+		Group("ms.team_position, opp.champion_id").
+		Order("matches DESC, opp.champion_id").
+		Scan(&matchups).Error; err != nil {
+		return nil, err
+	}
+
+	return matchups, nil
+}
+
+// GetChampionSynergies returns the matches and win rate of a champion with each ally on the same team.
+// Uses the same filters of the tierlist, the role is the position of the champion.
+func (cr *championRepository) GetChampionSynergies(ctx context.Context, championKey int, filters *filters.TierlistFilter) ([]*ChampionSynergyStats, error) {
+	var synergies []*ChampionSynergyStats
+
+	whereConditions := []string{"mi.queue_id = ?", "ms.team_position <> ''"}
+	args := []any{420}
+	if filters.Queue != 0 {
+		args[0] = filters.Queue
+	}
+
+	if filters.GetTiersAbove && filters.NumericTier != 0 {
+		whereConditions = append(whereConditions, "mi.average_rating >= ?")
+		args = append(args, filters.NumericTier)
+	}
+
+	if !filters.GetTiersAbove && filters.Tier != "" {
+		lower, higher := tiervalues.GetTierLimits(filters.Tier)
+		whereConditions = append(whereConditions, "mi.average_rating BETWEEN ? AND ?")
+		args = append(args, lower, higher)
+	}
+
+	if filters.Patch != "" {
+		whereConditions = append(whereConditions, "mi.patch = ?")
+		args = append(args, filters.Patch)
+	}
+
+	if filters.Region != "" {
+		whereConditions = append(whereConditions, "mi.region = ?")
+		args = append(args, filters.Region)
+	}
+
+	// The allies are bounded by the period too, so only the partitions of the period are scanned.
+	allyConditions := []string{"a.champion_id <> ?", "a.team_position <> ''"}
+	allyArgs := []any{championKey}
+
+	if !filters.Since.IsZero() {
+		whereConditions = append(whereConditions, "mi.match_start >= ? AND ms.match_start >= ?")
+		args = append(args, filters.Since, filters.Since)
+		allyConditions = append(allyConditions, "a.match_start >= ?")
+		allyArgs = append(allyArgs, filters.Since)
+	}
+
+	if !filters.Until.IsZero() {
+		whereConditions = append(whereConditions, "mi.match_start < ? AND ms.match_start < ?")
+		args = append(args, filters.Until, filters.Until)
+		allyConditions = append(allyConditions, "a.match_start < ?")
+		allyArgs = append(allyArgs, filters.Until)
+	}
+
+	championConditions := "ms.champion_id = ?"
+	championArgs := []any{championKey}
+	if filters.Role != "" {
+		championConditions += " AND ms.team_position = ?"
+		championArgs = append(championArgs, filters.Role)
+	}
+
+	minGames := defaultSynergyMinGames
+	if filters.MinGames > 0 {
+		minGames = filters.MinGames
+	}
+
+	// Only the picks of the champion's matches are paired, and only the allies found are aggregated for their win rates.
+	query := `
+	WITH champion_picks AS (
+		SELECT
+			ms.match_id,
+			ms.match_start,
+			ms.team_id,
+			ms.team_position,
+			ms.win
+		FROM
+			match_stats ms
+		JOIN
+			match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
+		WHERE ` + strings.Join(whereConditions, " AND ") + ` AND ` + championConditions + `
+	),
+	pairs AS (
+		SELECT
+			c.team_position,
+			a.champion_id AS ally_key,
+			a.team_position AS ally_position,
+			COUNT(*) AS matches,
+			AVG(c.win::int) AS win_rate
+		FROM
+			champion_picks c
+		JOIN
+			match_stats a ON a.match_id = c.match_id AND a.match_start = c.match_start AND a.team_id = c.team_id
+		WHERE ` + strings.Join(allyConditions, " AND ") + `
+		GROUP BY c.team_position, a.champion_id, a.team_position
+		HAVING COUNT(*) >= ?
+	),
+	champion_base AS (
+		SELECT
+			team_position,
+			AVG(win::int) AS win_rate
+		FROM champion_picks
+		GROUP BY team_position
+	),
+	ally_base AS (
+		SELECT
+			ms.champion_id,
+			ms.team_position,
+			AVG(ms.win::int) AS win_rate
+		FROM
+			match_stats ms
+		JOIN
+			match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
+		WHERE ` + strings.Join(whereConditions, " AND ") + `
+		AND (ms.champion_id, ms.team_position) IN (SELECT ally_key, ally_position FROM pairs)
+		GROUP BY ms.champion_id, ms.team_position
+	)
+	SELECT
+		p.team_position,
+		p.ally_key,
+		p.ally_position,
+		p.matches,
+		ROUND(p.win_rate * 100, 2) AS win_rate,
+		ROUND(cb.win_rate * 100, 2) AS champion_win_rate,
+		ROUND(ab.win_rate * 100, 2) AS ally_win_rate
+	FROM
+		pairs p
+	JOIN
+		champion_base cb ON cb.team_position = p.team_position
+	JOIN
+		ally_base ab ON ab.champion_id = p.ally_key AND ab.team_position = p.ally_position
+	ORDER BY p.matches DESC, p.ally_key
+	`
+
+	queryArgs := append([]any{}, args...)
+	queryArgs = append(queryArgs, championArgs...)
+	queryArgs = append(queryArgs, allyArgs...)
+	queryArgs = append(queryArgs, minGames)
+	queryArgs = append(queryArgs, args...)
+
+	if err := cr.db.WithContext(ctx).Raw(query, queryArgs...).Scan(&synergies).Error; err != nil {
+		return nil, err
+	}
+
+	return synergies, nil
+}
+
+// applyStatsFilter filters the match stats and infos of the query.
+// The other partitioned tables joined by the query are bounded by the period through their aliases.
+func applyStatsFilter(query *gorm.DB, championKey int, filters *filters.ChampionStatsFilter, partitionAliases ...string) *gorm.DB {
+	query = query.
+		Where("ms.champion_id = ?", championKey).
+		Where("mi.queue_id = ?", filters.Queue)
+
+	if filters.Role != "" {
+		query = query.Where("ms.team_position = ?", filters.Role)
+	}
+
+	if filters.MaxRating != 0 {
+		query = query.Where("mi.average_rating BETWEEN ? AND ?", filters.MinRating, filters.MaxRating)
+	} else if filters.MinRating != 0 {
+		query = query.Where("mi.average_rating >= ?", filters.MinRating)
+	}
+
+	if filters.Patch != "" {
+		query = query.Where("mi.patch = ?", filters.Patch)
+	}
+
+	// Bound every table, so only the partitions of the period are scanned.
+	if !filters.Since.IsZero() {
+		for _, alias := range append([]string{"mi", "ms"}, partitionAliases...) {
+			query = query.Where(fmt.Sprintf("%s.match_start >= ?", alias), filters.Since)
+		}
+	}
+
+	return query
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+	"goleague/api/filters"
+	"goleague/internal/testutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestNewChampionRepository(t *testing.T) {
+	repository := NewChampionRepository(&gorm.DB{})
+	assert.NotNil(t, repository)
+}
+
+func TestGetChampionMatchups(t *testing.T) {
+	db, cleanup := testutil.NewTestConnection(t)
+	defer cleanup()
+
+	repository := NewChampionRepository(db)
+
+	seedMatchupTestData(t, db)
+	tests := []struct {
+		name       string
+		filters    *filters.ChampionStatsFilter
+		returnData *testutil.OperationRestult[[]*ChampionMatchupStats]
+		setupFunc  func(db *gorm.DB)
+	}{
+		{
+			name:       "nofilters",
+			filters:    filters.NewChampionStatsFilter(&filters.ChampionURIParams{ChampionId: "Aatrox"}, filters.ChampionStatsQueryParams{}),
+			returnData: testutil.NewSuccessResult(getMatchupsExpectedResult()),
+		},
+		{
+			name:       "samerole",
+			filters:    filters.NewChampionStatsFilter(&filters.ChampionURIParams{ChampionId: "Aatrox"}, filters.ChampionStatsQueryParams{Role: "top", Patch: "15.19", Days: 7}),
+			returnData: testutil.NewSuccessResult(getMatchupsExpectedResult()),
+		},
+		{
+			name:       "otherrole",
+			filters:    filters.NewChampionStatsFilter(&filters.ChampionURIParams{ChampionId: "Aatrox"}, filters.ChampionStatsQueryParams{Role: "middle"}),
+			returnData: testutil.NewSuccessResult([]*ChampionMatchupStats{}),
+		},
+		{
+			name:       "otherpatch",
+			filters:    filters.NewChampionStatsFilter(&filters.ChampionURIParams{ChampionId: "Aatrox"}, filters.ChampionStatsQueryParams{Patch: "15.20"}),
+			returnData: testutil.NewSuccessResult([]*ChampionMatchupStats{}),
+		},
+		{
+			name:       "dbconnectionerr",
+			filters:    filters.NewChampionStatsFilter(&filters.ChampionURIParams{ChampionId: "Aatrox"}, filters.ChampionStatsQueryParams{}),
+			returnData: testutil.NewErrorResult[[]*ChampionMatchupStats]("sql: database is closed"),
+			setupFunc: func(db *gorm.DB) {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.setupFunc != nil {
+			tt.setupFunc(db)
+			sqlDb, _ := db.DB()
+			defer sqlDb.Conn(context.Background())
+		}
+
+		result, err := repository.GetChampionMatchups(context.Background(), seededChampionKey, tt.filters)
+
+		if tt.returnData.Err != nil {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.returnData.Err.Error())
+			assert.Nil(t, result)
+			continue
+		}
+
+		assert.NoError(t, err)
+		if len(tt.returnData.Data) == 0 {
+			assert.Empty(t, result)
+			continue
+		}
+
+		assert.Equal(t, tt.returnData.Data, result)
+	}
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"goleague/pkg/database/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Champion of the seeded matchups and its lane opponents.
+const (
+	seededChampionKey       = 266
+	seededFramedOpponentKey = 122
+	seededOpponentKey       = 84
+)
+
+func seedMatchupTestData(t *testing.T, db *gorm.DB) {
+	// Clean up existing data
+	db.Exec("TRUNCATE TABLE participant_frames, match_stats, match_bans, match_infos, player_infos CASCADE")
+
+	// Recent matches, on the partitions created by the migrations.
+	matchStart := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	// Frames every minute, the 10 and 15 minutes frames are the indexes 10 and 15.
+	matchInfos := []*models.MatchInfo{
+		{ID: 1, QueueId: 420, MatchId: "BR1_1", Region: "BR1", MatchStart: matchStart, Patch: "15.19", FrameInterval: 60000},
+		{ID: 2, QueueId: 420, MatchId: "BR1_2", Region: "BR1", MatchStart: matchStart, Patch: "15.19", FrameInterval: 60000},
+		{ID: 3, QueueId: 420, MatchId: "BR1_3", Region: "BR1", MatchStart: matchStart, Patch: "15.19", FrameInterval: 60000},
+	}
+
+	for _, mi := range matchInfos {
+		err := db.Create(mi).Error
+		require.NoError(t, err)
+	}
+
+	playerInfos := []*models.PlayerInfo{
+		{ID: 1, Puuid: "P1"},
+		{ID: 2, Puuid: "P2"},
+		{ID: 3, Puuid: "P3"},
+		{ID: 4, Puuid: "P4"},
+		{ID: 5, Puuid: "P5"},
+		{ID: 6, Puuid: "P6"},
+	}
+
+	for _, pi := range playerInfos {
+		err := db.Create(pi).Error
+		require.NoError(t, err)
+	}
+
+	// The champion is always on the top lane of the blue side.
+	matchStats := []*models.MatchStats{
+		// Match 1 - Won against the framed opponent.
+		{ID: 1, MatchId: 1, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededChampionKey, ParticipantId: 1, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 2, MatchId: 1, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededFramedOpponentKey, ParticipantId: 6, TeamId: 200, TeamPosition: "TOP", Win: false}},
+		{ID: 3, MatchId: 1, PlayerId: 3, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 64, ParticipantId: 2, TeamId: 100, TeamPosition: "JUNGLE", Win: true}},
+
+		// Match 2 - Lost against the framed opponent, ended before the 15 minutes frame.
+		{ID: 4, MatchId: 2, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededChampionKey, ParticipantId: 1, TeamId: 100, TeamPosition: "TOP", Win: false}},
+		{ID: 5, MatchId: 2, PlayerId: 4, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededFramedOpponentKey, ParticipantId: 6, TeamId: 200, TeamPosition: "TOP", Win: true}},
+
+		// Match 3 - Won against an opponent without a timeline.
+		{ID: 6, MatchId: 3, PlayerId: 5, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededChampionKey, ParticipantId: 1, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 7, MatchId: 3, PlayerId: 6, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: seededOpponentKey, ParticipantId: 6, TeamId: 200, TeamPosition: "TOP", Win: false}},
+	}
+
+	for _, ms := range matchStats {
+		err := db.Omit(clause.Associations).Create(ms).Error
+		require.NoError(t, err)
+	}
+
+	participantFrames := []*models.ParticipantFrame{
+		// Match 1, ahead on both frames.
+		{MatchStatId: 1, FrameIndex: 10, MatchStart: matchStart, TotalGold: 4000, XP: 4500, MinionsKilled: 80},
+		{MatchStatId: 2, FrameIndex: 10, MatchStart: matchStart, TotalGold: 3500, XP: 4300, MinionsKilled: 70},
+		{MatchStatId: 1, FrameIndex: 15, MatchStart: matchStart, TotalGold: 6500, XP: 7600, MinionsKilled: 115, JungleMinionsKilled: 5},
+		{MatchStatId: 2, FrameIndex: 15, MatchStart: matchStart, TotalGold: 6000, XP: 7400, MinionsKilled: 115},
+
+		// Match 2, behind at 10 minutes.
+		{MatchStatId: 4, FrameIndex: 10, MatchStart: matchStart, TotalGold: 3000, XP: 4000, MinionsKilled: 60},
+		{MatchStatId: 5, FrameIndex: 10, MatchStart: matchStart, TotalGold: 3400, XP: 4200, MinionsKilled: 75},
+
+		// Frames that aren't on the matchup minutes.
+		{MatchStatId: 1, FrameIndex: 9, MatchStart: matchStart, TotalGold: 100000},
+		{MatchStatId: 2, FrameIndex: 11, MatchStart: matchStart, TotalGold: 100000},
+	}
+
+	for _, pf := range participantFrames {
+		err := db.Omit(clause.Associations).Create(pf).Error
+		require.NoError(t, err)
+	}
+}
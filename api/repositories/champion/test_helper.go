@@ -0,0 +1,30 @@
+package repositories
+
+// The differences at each minute only use the frames of that minute, the matches without it are left out of the average.
+// The opponent without a timeline has the matches, but no differences.
+func getMatchupsExpectedResult() []*ChampionMatchupStats {
+	return []*ChampionMatchupStats{
+		{
+			TeamPosition: "TOP",
+			OpponentKey:  seededFramedOpponentKey,
+			Matches:      2,
+			WinRate:      50,
+			GoldDiffAt10: floatPointer(50),
+			XpDiffAt10:   floatPointer(0),
+			CsDiffAt10:   floatPointer(-2.5),
+			GoldDiffAt15: floatPointer(500),
+			XpDiffAt15:   floatPointer(200),
+			CsDiffAt15:   floatPointer(5),
+		},
+		{
+			TeamPosition: "TOP",
+			OpponentKey:  seededOpponentKey,
+			Matches:      1,
+			WinRate:      100,
+		},
+	}
+}
+
+func floatPointer(value float64) *float64 {
+	return &value
+}
@@ -0,0 +1,122 @@
+package repositories
+
+import (
+	"context"
+	"goleague/api/filters"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// LeaderboardRepository is the public interface for accessing the precomputed ladder.
+type LeaderboardRepository interface {
+	GetLeaderboard(ctx context.Context, filters *filters.LeaderboardFilter) ([]*LeaderboardEntry, error)
+	GetMostPlayedChampions(ctx context.Context, playerIds []uint, queueId int, since time.Time, limit int) ([]*PlayerChampionCount, error)
+}
+
+// LeaderboardEntry is a player on the ladder, the previous position is null for new players.
+type LeaderboardEntry struct {
+	PlayerId         uint
+	RiotIdGameName   string
+	RiotIdTagline    string
+	ProfileIcon      int
+	Region           string
+	Tier             string
+	Rank             string
+	LeaguePoints     int
+	NumericScore     int
+	Wins             int
+	Losses           int
+	Position         int
+	PreviousPosition *int
+}
+
+// PlayerChampionCount is the amount of matches of a player with a champion.
+type PlayerChampionCount struct {
+	PlayerId   uint
+	ChampionId int
+	Matches    int64
+}
+
+// leaderboardRepository repository structure.
+type leaderboardRepository struct {
+	db *gorm.DB
+}
+
+// NewLeaderboardRepository creates a leaderboard repository.
+func NewLeaderboardRepository(db *gorm.DB) LeaderboardRepository {
+	return &leaderboardRepository{db: db}
+}
+
+// GetLeaderboard returns a page of the ladder, by the position on the region.
+// The positions are kept when filtering by tier.
+func (lr *leaderboardRepository) GetLeaderboard(ctx context.Context, filters *filters.LeaderboardFilter) ([]*LeaderboardEntry, error) {
+	var entries []*LeaderboardEntry
+
+	query := lr.db.WithContext(ctx).
+		Table("leaderboard_entries le").
+		Select(`
+			le.player_id,
+			pi.riot_id_game_name,
+			pi.riot_id_tagline,
+			pi.profile_icon,
+			le.region,
+			le.tier,
+			le.rank,
+			le.league_points,
+			le.numeric_score,
+			le.wins,
+			le.losses,
+			le.position,
+			le.previous_position
+		`).
+		Joins("JOIN player_infos pi ON pi.id = le.player_id").
+		Where("le.region = ? AND le.queue = ?", filters.Region, filters.Queue)
+
+	if filters.Tier != "" {
+		query = query.Where("le.tier = ?", filters.Tier)
+	}
+
+	if err := query.
+		Order("le.position").
+		Offset((filters.Page - 1) * filters.PageSize).
+		Limit(filters.PageSize).
+		Scan(&entries).Error; err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// GetMostPlayedChampions returns the most played champions of each player on a queue, most played first.
+func (lr *leaderboardRepository) GetMostPlayedChampions(ctx context.Context, playerIds []uint, queueId int, since time.Time, limit int) ([]*PlayerChampionCount, error) {
+	var counts []*PlayerChampionCount
+	if len(playerIds) == 0 {
+		return counts, nil
+	}
+
+	if err := lr.db.WithContext(ctx).Raw(`
+	SELECT player_id, champion_id, matches
+	FROM (
+		SELECT
+			ms.player_id,
+			ms.champion_id,
+			COUNT(*) AS matches,
+			ROW_NUMBER() OVER (PARTITION BY ms.player_id ORDER BY COUNT(*) DESC, ms.champion_id) AS champion_rank
+		FROM
+			match_stats ms
+		JOIN
+			match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
+		WHERE ms.player_id IN ?
+		AND mi.queue_id = ?
+		AND mi.match_start >= ? AND ms.match_start >= ?
+		GROUP BY ms.player_id, ms.champion_id
+	) ranked
+	WHERE champion_rank <= ?
+	ORDER BY player_id, champion_rank
+	`, playerIds, queueId, since, since, limit).Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
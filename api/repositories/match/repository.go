@@ -9,6 +9,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// matchStartSubquery gets the start of a match by the internal id.
+// Comparing the partitioned tables against it lets the planner skip every other month.
+const matchStartSubquery = "(SELECT match_start FROM match_infos WHERE id = ?)"
+
 // MatchRepository is the public interface for accessing the player repository.
 type MatchRepository interface {
 	GetAllEvents(ctx context.Context, matchID uint) ([]models.AllEvents, error)
@@ -16,6 +20,7 @@ type MatchRepository interface {
 	GetMatchPreviewsByInternalId(ctx context.Context, matchID uint) ([]RawMatchPreview, error)
 	GetMatchPreviewsByInternalIds(ctx context.Context, matchIDs []uint) ([]RawMatchPreview, error)
 	GetParticipantFramesByInternalId(ctx context.Context, matchID uint) ([]RawMatchParticipantFrame, error)
+	HasPendingTimeline(ctx context.Context, matchID uint) (bool, error)
 }
 
 // matchRepository repository structure.
@@ -86,7 +91,11 @@ type RawMatchParticipantFrame struct {
 // GetAllEvents retrieves all events for a given match internal ID.
 func (ms *matchRepository) GetAllEvents(ctx context.Context, matchID uint) ([]models.AllEvents, error) {
 	var results []models.AllEvents
-	if err := ms.db.WithContext(ctx).Where("match_id = ?", matchID).Order("timestamp asc").Find(&results).Error; err != nil {
+	if err := ms.db.WithContext(ctx).
+		Where("match_id = ?", matchID).
+		Where("match_start = "+matchStartSubquery, matchID).
+		Order("timestamp asc").
+		Find(&results).Error; err != nil {
 		return nil, err
 	}
 
@@ -130,9 +139,10 @@ func (ms *matchRepository) GetMatchPreviewsByInternalIds(ctx context.Context, ma
 		JOIN match_infos mi ON ms.match_id = mi.id
 		JOIN player_infos pi ON ms.player_id = pi.id
 		WHERE ms.match_id IN ?
+		AND ms.match_start = ANY(ARRAY(SELECT match_start FROM match_infos WHERE id IN ?))
 	`
 
-	if err := ms.db.WithContext(ctx).Raw(query, matchIDs).Scan(&rawResults).Error; err != nil {
+	if err := ms.db.WithContext(ctx).Raw(query, matchIDs, matchIDs).Scan(&rawResults).Error; err != nil {
 		return nil, err
 	}
 
@@ -176,9 +186,10 @@ func (ms *matchRepository) GetMatchPreviewsByInternalId(ctx context.Context, mat
 		JOIN match_infos mi ON ms.match_id = mi.id
 		JOIN player_infos pi ON ms.player_id = pi.id
 		WHERE ms.match_id = ?
+		AND ms.match_start = ` + matchStartSubquery + `
 	`
 
-	if err := ms.db.WithContext(ctx).Raw(query, matchID).Scan(&rawResults).Error; err != nil {
+	if err := ms.db.WithContext(ctx).Raw(query, matchID, matchID).Scan(&rawResults).Error; err != nil {
 		return nil, err
 	}
 
@@ -203,8 +214,10 @@ func (ms *matchRepository) GetParticipantFramesByInternalId(ctx context.Context,
 		WithContext(ctx).
 		Table("participant_frames").
 		Select("participant_frames.*").
-		Joins("JOIN match_stats ON participant_frames.match_stat_id = match_stats.id").
+		Joins("JOIN match_stats ON participant_frames.match_stat_id = match_stats.id AND participant_frames.match_start = match_stats.match_start").
 		Where("match_stats.match_id = ?", matchID).
+		Where("match_stats.match_start = "+matchStartSubquery, matchID).
+		Where("participant_frames.match_start = "+matchStartSubquery, matchID).
 		Find(&results).Error
 
 	if err != nil {
@@ -212,3 +225,15 @@ func (ms *matchRepository) GetParticipantFramesByInternalId(ctx context.Context,
 	}
 	return results, nil
 }
+
+// HasPendingTimeline checks if the timeline of a match was deferred and is still waiting to be fetched.
+func (ms *matchRepository) HasPendingTimeline(ctx context.Context, matchID uint) (bool, error) {
+	var pending bool
+	if err := ms.db.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM pending_timelines WHERE match_id = ?)", matchID).
+		Scan(&pending).Error; err != nil {
+		return false, err
+	}
+
+	return pending, nil
+}
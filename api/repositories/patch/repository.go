@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"goleague/pkg/database/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// PatchRepository is the public interface for accessing the patches of the stored matches.
+type PatchRepository interface {
+	GetPatches(ctx context.Context) ([]PatchMatchCount, error)
+}
+
+// PatchMatchCount is the amount of matches stored on a given patch.
+type PatchMatchCount struct {
+	Patch      string
+	Matches    int64
+	FirstMatch time.Time
+	LastMatch  time.Time
+}
+
+// patchRepository repository structure.
+type patchRepository struct {
+	db *gorm.DB
+}
+
+// NewPatchRepository creates a patch repository.
+func NewPatchRepository(db *gorm.DB) PatchRepository {
+	return &patchRepository{db: db}
+}
+
+// GetPatches returns the patches with their match counts, newest first.
+// The patches are ordered numerically, so 15.10 comes before 15.9.
+func (pr *patchRepository) GetPatches(ctx context.Context) ([]PatchMatchCount, error) {
+	var patches []PatchMatchCount
+	if err := pr.db.WithContext(ctx).
+		Model(&models.MatchInfo{}).
+		Select(`
+			patch,
+			COUNT(*) AS matches,
+			MIN(match_start) AS first_match,
+			MAX(match_start) AS last_match
+		`).
+		Where("patch <> ''").
+		Group("patch").
+		Order("string_to_array(patch, '.')::INT[] DESC").
+		Scan(&patches).Error; err != nil {
+		return nil, err
+	}
+
+	return patches, nil
+}
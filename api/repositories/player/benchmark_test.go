@@ -0,0 +1,37 @@
+package repositories
+
+import (
+	"context"
+	"goleague/api/filters"
+	"goleague/internal/testutil"
+	"testing"
+)
+
+func BenchmarkGetPlayerStats(b *testing.B) {
+	db, cleanup := testutil.NewTestConnection(b)
+	defer cleanup()
+
+	testutil.SeedBenchmarkMatches(b, db, testutil.BenchmarkMatchCount())
+	repository := NewPlayerRepository(db)
+
+	playerId := uint(1)
+	benchmarks := []struct {
+		name     string
+		interval int
+	}{
+		{name: "lastweek", interval: 7},
+		{name: "lastmonth", interval: 30},
+		{name: "lastthreemonths", interval: 90},
+	}
+
+	for _, bb := range benchmarks {
+		b.Run(bb.name, func(b *testing.B) {
+			filters := &filters.PlayerStatsFilter{PlayerId: &playerId, Interval: bb.interval}
+			for b.Loop() {
+				if _, err := repository.GetPlayerStats(context.Background(), filters); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"goleague/api/filters"
+	"goleague/pkg/database"
 	"goleague/pkg/database/models"
 	"goleague/pkg/messages"
 	"goleague/pkg/regions"
@@ -21,9 +22,16 @@ type PlayerRepository interface {
 	SearchPlayer(ctx context.Context, filters *filters.PlayerSearchFilter) ([]*models.PlayerInfo, error)
 	GetPlayerById(ctx context.Context, playerId uint) (*models.PlayerInfo, error)
 	GetPlayerByNameTagRegion(ctx context.Context, name string, tag string, region string) (*models.PlayerInfo, error)
-	GetPlayerMatchHistoryIds(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]uint, error)
+	GetPlayerDuoMatches(ctx context.Context, filters *filters.PlayerDuoFilter) ([]DuoMatch, error)
+	GetPlayerMatchHistoryEntries(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]MatchHistoryEntry, error)
+	GetPlayerRatingHistory(ctx context.Context, filters *filters.PlayerRatingHistoryFilter) ([]models.RatingEntry, error)
+	GetPlayerRatingPeaks(ctx context.Context, playerId uint, queue string) ([]RatingPeak, error)
 	GetPlayerRatingsById(ctx context.Context, playerId uint) ([]models.RatingEntry, error)
 	GetPlayerStats(ctx context.Context, filters *filters.PlayerStatsFilter) ([]RawPlayerStatsStruct, error)
+	GetPlayersByNameTagRegion(ctx context.Context, players []filters.PlayerURIParams) ([]*models.PlayerInfo, error)
+	GetPlayersRatingsByIds(ctx context.Context, playerIds []uint) ([]models.RatingEntry, error)
+	GetPlayersStats(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]RawPlayerStatsStruct, error)
+	GetSharedMatches(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]SharedMatchParticipant, error)
 }
 
 // playerRepository repository structure.
@@ -36,19 +44,62 @@ func NewPlayerRepository(db *gorm.DB) PlayerRepository {
 	return &playerRepository{db: db}
 }
 
+// DuoMatch is a recent match of a player with one of the teammates.
+// The match index is the position of the match on the player history, oldest first.
+type DuoMatch struct {
+	MatchIndex     int
+	Win            bool
+	PartnerId      uint
+	RiotIdGameName string
+	RiotIdTagline  string
+	ProfileIcon    int
+}
+
+// MatchHistoryEntry is a match of a player history, the start is kept for the cursor.
+type MatchHistoryEntry struct {
+	Id           uint
+	MatchStart   time.Time
+	TeamPosition string
+}
+
+// SharedMatchParticipant is one of the given players on a match played by more than one of them.
+type SharedMatchParticipant struct {
+	MatchId  uint
+	PlayerId uint
+	TeamId   int
+	Win      bool
+}
+
+// RatingPeak is the highest rating of a player on a season.
+type RatingPeak struct {
+	Season       int
+	Tier         string
+	Rank         string
+	LeaguePoints int
+	NumericScore int
+	FetchTime    time.Time
+}
+
 // RawPlayerStatsStruct is the raw data from the player stats analysis.
 type RawPlayerStatsStruct struct {
-	Matches          int     `gorm:"column:matches"`
-	QueueId          int     `gorm:"column:queue_id"`
-	TeamPosition     string  `gorm:"column:team_position"`
-	ChampionId       int     `gorm:"column:champion_id"`
-	WinRate          float32 `gorm:"column:win_rate"`
-	AverageKills     float32 `gorm:"column:avg_kills"`
-	AverageDeaths    float32 `gorm:"column:avg_deaths"`
-	AverageAssists   float32 `gorm:"column:avg_assists"`
-	CsPerMin         float32 `gorm:"column:cs_per_min"`
-	KDA              float32 `gorm:"column:kda"`
-	AggregationLevel string  `gorm:"column:aggregation_level"`
+	PlayerId         uint     `gorm:"column:player_id"`
+	Matches          int      `gorm:"column:matches"`
+	QueueId          int      `gorm:"column:queue_id"`
+	TeamPosition     string   `gorm:"column:team_position"`
+	ChampionId       int      `gorm:"column:champion_id"`
+	WinRate          float32  `gorm:"column:win_rate"`
+	AverageKills     float32  `gorm:"column:avg_kills"`
+	AverageDeaths    float32  `gorm:"column:avg_deaths"`
+	AverageAssists   float32  `gorm:"column:avg_assists"`
+	CsPerMin         float32  `gorm:"column:cs_per_min"`
+	KDA              float32  `gorm:"column:kda"`
+	GoldDiffAt10     *float32 `gorm:"column:gold_diff_at10"`
+	XpDiffAt10       *float32 `gorm:"column:xp_diff_at10"`
+	CsDiffAt10       *float32 `gorm:"column:cs_diff_at10"`
+	GoldDiffAt15     *float32 `gorm:"column:gold_diff_at15"`
+	XpDiffAt15       *float32 `gorm:"column:xp_diff_at15"`
+	CsDiffAt15       *float32 `gorm:"column:cs_diff_at15"`
+	AggregationLevel string   `gorm:"column:aggregation_level"`
 }
 
 // SearchPlayer searchs a given player by it's name, tag and region.
@@ -90,39 +141,70 @@ func (ps *playerRepository) SearchPlayer(ctx context.Context, filters *filters.P
 	return players, nil
 }
 
-// GetPlayerMatchHistoryIds returns the internal ids of the matches that a given player played.
-func (ps *playerRepository) GetPlayerMatchHistoryIds(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]uint, error) {
+// GetPlayerMatchHistoryEntries returns the matches of a player, newest first.
+// Returns one match more than the page size when there is a next page.
+func (ps *playerRepository) GetPlayerMatchHistoryEntries(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]MatchHistoryEntry, error) {
 	if filters == nil {
 		return nil, fmt.Errorf(messages.FiltersNotNil)
 	}
-	var ids []uint
+	var entries []MatchHistoryEntry
 
-	defaultLimit := 10
-	playerId := filters.PlayerId
-	queueId := filters.Queue
+	query := ps.db.WithContext(ctx).Model(&models.MatchInfo{}).
+		Select("match_infos.id, match_infos.match_start, ms.team_position").
+		Joins("JOIN match_stats ms on match_infos.id=ms.match_id AND ms.match_start = match_infos.match_start").
+		Where("ms.player_id = ?", filters.PlayerId)
 
-	query := ps.db.Model(&models.MatchInfo{}).
-		Select("match_infos.id").
-		Joins("JOIN match_stats ms on match_infos.id=ms.match_id").
-		Where("ms.player_id = ?", playerId)
+	if filters.Queue != 0 {
+		query = query.Where("match_infos.queue_id = ?", filters.Queue)
+	}
 
-	if queueId != 0 {
-		query = query.Where("match_infos.queue_id = ?", queueId)
+	if filters.ChampionId != 0 {
+		query = query.Where("ms.champion_id = ?", filters.ChampionId)
 	}
 
-	query = query.Limit(defaultLimit)
+	if filters.Role != "" {
+		query = query.Where("ms.team_position = ?", filters.Role)
+	}
 
-	offset := filters.Page
-	if offset != 0 {
-		query = query.Offset(filters.Page * defaultLimit)
+	if filters.Win != nil {
+		query = query.Where("ms.win = ?", *filters.Win)
+	}
+
+	if filters.Patch != "" {
+		query = query.Where("match_infos.patch = ?", filters.Patch)
+	}
+
+	// The bounds are repeated on the match stats so only the partitions of the range are scanned.
+	if !filters.Since.IsZero() {
+		query = query.Where("match_infos.match_start >= ? AND ms.match_start >= ?", filters.Since, filters.Since)
+	}
+
+	if !filters.Until.IsZero() {
+		query = query.Where("match_infos.match_start < ? AND ms.match_start < ?", filters.Until, filters.Until)
+	}
+
+	// The id breaks the ties of matches that started at the same time.
+	if filters.Cursor != nil {
+		query = query.Where(
+			"(match_infos.match_start, match_infos.id) < (?, ?) AND ms.match_start <= ?",
+			filters.Cursor.MatchStart, filters.Cursor.MatchId, filters.Cursor.MatchStart,
+		)
 	}
 
-	err := query.Pluck("id", &ids).Error
+	// The offset pages of the older clients, only without a cursor.
+	if filters.Cursor == nil && filters.Page > 0 {
+		query = query.Offset(filters.Page * filters.PageSize)
+	}
+
+	err := query.
+		Order("match_infos.match_start DESC, match_infos.id DESC").
+		Limit(filters.PageSize + 1).
+		Scan(&entries).Error
 	if err != nil {
 		return nil, err
 	}
 
-	return ids, nil
+	return entries, nil
 }
 
 // GetPlayerStats returns the raw player stats.
@@ -130,31 +212,54 @@ func (ps *playerRepository) GetPlayerStats(ctx context.Context, filters *filters
 	if filters == nil {
 		return nil, fmt.Errorf(messages.FiltersNotNil)
 	}
-	var playerStats []RawPlayerStatsStruct
-	playerId := filters.PlayerId
-	interval := filters.Interval
 
-	// Set a default interval if not provided.
-	if interval == 0 {
-		interval = 30
+	var playerIds []uint
+	if filters.PlayerId != nil {
+		playerIds = []uint{*filters.PlayerId}
 	}
 
-	timeThreshold := time.Now().AddDate(0, 0, -interval)
+	return ps.GetPlayersStats(ctx, playerIds, filters.Start(), filters.Patch)
+}
 
+// GetPlayersStats returns the raw stats of each player, with the top champions of each one.
+// Uses the same period and patch for every player, like the stats of a single player.
+func (ps *playerRepository) GetPlayersStats(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]RawPlayerStatsStruct, error) {
+	var playerStats []RawPlayerStatsStruct
+
+	// The top champions only join the match information when filtering by patch.
+	topChampionsPatch := ""
+	statsPatch := ""
+	topChampionsArgs := []any{playerIds, since}
+	statsArgs := []any{playerIds, since}
+	if patch != "" {
+		topChampionsPatch = "AND EXISTS (SELECT 1 FROM match_infos mi WHERE mi.id = ms.match_id AND mi.patch = ?)"
+		statsPatch = "AND mi.patch = ?"
+		topChampionsArgs = append(topChampionsArgs, patch)
+		statsArgs = append(statsArgs, patch)
+	}
+
+	// Bounding the match stats by the match start limits the scan to the partitions of the interval.
+	// A single lane opponent is picked, a position repeated on the enemy team must not duplicate the stats rows.
 	query := `
 		WITH top_champions AS (
-		    SELECT champion_id
-		    FROM match_stats ms
-		    JOIN match_infos mi ON ms.match_id = mi.id
-		    WHERE ms.player_id = ? 
-		      AND mi.match_start >= ?
-		      AND ms.champion_id IS NOT NULL
-		    GROUP BY champion_id
-		    ORDER BY COUNT(*) DESC
-		    LIMIT 10
+		    SELECT player_id, champion_id
+		    FROM (
+		        SELECT
+		            ms.player_id,
+		            ms.champion_id,
+		            ROW_NUMBER() OVER (PARTITION BY ms.player_id ORDER BY COUNT(*) DESC) AS champion_rank
+		        FROM match_stats ms
+		        WHERE ms.player_id IN ?
+		          AND ms.match_start >= ?
+		          AND ms.champion_id IS NOT NULL
+		          ` + topChampionsPatch + `
+		        GROUP BY ms.player_id, ms.champion_id
+		    ) ranked_champions
+		    WHERE champion_rank <= 10
 		),
 		base_stats AS (
 		    SELECT 
+		        ms.player_id,
 		        COUNT(*) AS matches,
 		        mi.queue_id,
 		        ms.team_position,
@@ -168,27 +273,59 @@ func (ps *playerRepository) GetPlayerStats(ctx context.Context, filters *filters
 		            WHEN AVG(ms.deaths) = 0 THEN AVG(ms.kills) + AVG(ms.assists)
 		            ELSE (AVG(ms.kills) + AVG(ms.assists)) / AVG(ms.deaths)
 		        END AS kda,
+		        AVG(pf10.total_gold - opf10.total_gold) AS gold_diff_at10,
+		        AVG(pf10.xp - opf10.xp) AS xp_diff_at10,
+		        AVG(
+		            (pf10.minions_killed + pf10.jungle_minions_killed) -
+		            (opf10.minions_killed + opf10.jungle_minions_killed)
+		        ) AS cs_diff_at10,
+		        AVG(pf15.total_gold - opf15.total_gold) AS gold_diff_at15,
+		        AVG(pf15.xp - opf15.xp) AS xp_diff_at15,
+		        AVG(
+		            (pf15.minions_killed + pf15.jungle_minions_killed) -
+		            (opf15.minions_killed + opf15.jungle_minions_killed)
+		        ) AS cs_diff_at15,
 		        MIN(mi.match_start) AS first_game,
 		        MAX(mi.match_start) AS last_game,
 		        GROUPING(mi.queue_id) AS is_queue_total,
 		        GROUPING(ms.team_position) AS is_position_total,
 		        GROUPING(ms.champion_id) AS is_champion_total
 		    FROM match_stats ms
-		    JOIN match_infos mi ON ms.match_id = mi.id
-		    WHERE ms.player_id = ?
-		      AND mi.match_start >= ?
+		    JOIN match_infos mi ON ms.match_id = mi.id AND ms.match_start = mi.match_start
+		    LEFT JOIN LATERAL (
+		        SELECT o.id, o.match_start
+		        FROM match_stats o
+		        WHERE o.match_id = ms.match_id
+		          AND o.match_start = ms.match_start
+		          AND o.team_position = ms.team_position
+		          AND o.team_id <> ms.team_id
+		          AND o.team_position <> ''
+		        ORDER BY o.id
+		        LIMIT 1
+		    ) opp ON TRUE
+		    ` + database.FrameJoin("pf10", "ms", 10) + `
+		    ` + database.FrameJoin("opf10", "opp", 10) + `
+		    ` + database.FrameJoin("pf15", "ms", 15) + `
+		    ` + database.FrameJoin("opf15", "opp", 15) + `
+		    WHERE ms.player_id IN ?
+		      AND ms.match_start >= ?
 			  AND mi.queue_id != 1700
+		      ` + statsPatch + `
 		    GROUP BY GROUPING SETS (
-		        (),
-		        (mi.queue_id),
-		        (ms.team_position),
-		        (ms.champion_id),
-		        (mi.queue_id, ms.team_position),
-		        (mi.queue_id, ms.champion_id)
+		        (ms.player_id),
+		        (ms.player_id, mi.queue_id),
+		        (ms.player_id, ms.team_position),
+		        (ms.player_id, ms.champion_id),
+		        (ms.player_id, mi.queue_id, ms.team_position),
+		        (ms.player_id, mi.queue_id, ms.champion_id)
+		    )
+		    HAVING (
+		        GROUPING(ms.champion_id) = 1 OR
+		        (ms.player_id, ms.champion_id) IN (SELECT player_id, champion_id FROM top_champions)
 		    )
-		    HAVING (GROUPING(ms.champion_id) = 1 OR ms.champion_id IN (SELECT champion_id FROM top_champions))
 		)
-		SELECT 
+		SELECT
+		    player_id,
 		    matches,
 		    COALESCE(queue_id, -1) AS queue_id,
 		    COALESCE(team_position, 'ALL') AS team_position,
@@ -199,6 +336,12 @@ func (ps *playerRepository) GetPlayerStats(ctx context.Context, filters *filters
 		    ROUND(avg_assists, 2) AS avg_assists,
 		    ROUND(cs_per_min, 2) AS cs_per_min,
 		    ROUND(kda, 2) AS kda,
+		    ROUND(gold_diff_at10, 2) AS gold_diff_at10,
+		    ROUND(xp_diff_at10, 2) AS xp_diff_at10,
+		    ROUND(cs_diff_at10, 2) AS cs_diff_at10,
+		    ROUND(gold_diff_at15, 2) AS gold_diff_at15,
+		    ROUND(xp_diff_at15, 2) AS xp_diff_at15,
+		    ROUND(cs_diff_at15, 2) AS cs_diff_at15,
 		    CASE 
 		        WHEN is_queue_total = 1 AND is_position_total = 1 AND is_champion_total = 1 THEN 'overall'
 		        WHEN is_queue_total = 0 AND is_position_total = 1 AND is_champion_total = 1 THEN 'by_queue'
@@ -209,9 +352,10 @@ func (ps *playerRepository) GetPlayerStats(ctx context.Context, filters *filters
 		        ELSE 'detailed'
 		    END AS aggregation_level
 		FROM base_stats
+		ORDER BY player_id
 	`
 
-	if err := ps.db.Raw(query, playerId, timeThreshold, playerId, timeThreshold).Scan(&playerStats).Error; err != nil {
+	if err := ps.db.WithContext(ctx).Raw(query, append(topChampionsArgs, statsArgs...)...).Scan(&playerStats).Error; err != nil {
 		return nil, err
 	}
 
@@ -235,6 +379,28 @@ func (ps *playerRepository) GetPlayerByNameTagRegion(ctx context.Context, name,
 	return &player, nil
 }
 
+// GetPlayersByNameTagRegion returns the players found among the given ones, in any order.
+// The missing players are left out, so the callers can tell which of them weren't found.
+func (ps *playerRepository) GetPlayersByNameTagRegion(ctx context.Context, players []filters.PlayerURIParams) ([]*models.PlayerInfo, error) {
+	if len(players) == 0 {
+		return []*models.PlayerInfo{}, nil
+	}
+
+	keys := make([][]any, len(players))
+	for i, player := range players {
+		keys[i] = []any{player.GameName, player.GameTag, regions.SubRegion(strings.ToUpper(player.Region))}
+	}
+
+	var found []*models.PlayerInfo
+	if err := ps.db.WithContext(ctx).
+		Where("(riot_id_game_name, riot_id_tagline, region) IN ?", keys).
+		Find(&found).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch the players: %v", err)
+	}
+
+	return found, nil
+}
+
 // GetPlayerInfo returns all the player information.
 func (ps *playerRepository) GetPlayerById(ctx context.Context, playerId uint) (*models.PlayerInfo, error) {
 	var player models.PlayerInfo
@@ -247,13 +413,18 @@ func (ps *playerRepository) GetPlayerById(ctx context.Context, playerId uint) (*
 
 // GetPlayerRatingById returns all the rating information regarding a player.
 func (ps *playerRepository) GetPlayerRatingsById(ctx context.Context, playerId uint) ([]models.RatingEntry, error) {
+	return ps.GetPlayersRatingsByIds(ctx, []uint{playerId})
+}
+
+// GetPlayersRatingsByIds returns the latest rating of each player on each queue and region, ordered by the player.
+func (ps *playerRepository) GetPlayersRatingsByIds(ctx context.Context, playerIds []uint) ([]models.RatingEntry, error) {
 	var ratings []models.RatingEntry
-	err := ps.db.Raw(`
-    SELECT DISTINCT ON (queue, region) *
+	err := ps.db.WithContext(ctx).Raw(`
+    SELECT DISTINCT ON (player_id, queue, region) *
     	FROM rating_entries
-    	WHERE player_id = ?
-    	ORDER BY queue, region, id DESC
-	`, playerId).Scan(&ratings).Error
+    	WHERE player_id IN ?
+    	ORDER BY player_id, queue, region, id DESC
+	`, playerIds).Scan(&ratings).Error
 
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get latest ratings: %v", err)
@@ -261,3 +432,151 @@ func (ps *playerRepository) GetPlayerRatingsById(ctx context.Context, playerId u
 
 	return ratings, nil
 }
+
+// GetPlayerRatingHistory returns the ratings of a player on a queue, oldest first.
+// On daily filters, only the last rating of each day is returned.
+func (ps *playerRepository) GetPlayerRatingHistory(ctx context.Context, filters *filters.PlayerRatingHistoryFilter) ([]models.RatingEntry, error) {
+	var ratings []models.RatingEntry
+
+	query := `
+	SELECT *
+		FROM rating_entries
+		WHERE player_id = ? AND queue = ? AND fetch_time >= ? AND fetch_time < ?
+		ORDER BY fetch_time
+	`
+	if filters.Daily {
+		query = `
+		SELECT DISTINCT ON (date_trunc('day', fetch_time AT TIME ZONE 'UTC')) *
+			FROM rating_entries
+			WHERE player_id = ? AND queue = ? AND fetch_time >= ? AND fetch_time < ?
+			ORDER BY date_trunc('day', fetch_time AT TIME ZONE 'UTC'), fetch_time DESC
+		`
+	}
+
+	err := ps.db.WithContext(ctx).
+		Raw(query, filters.PlayerId, filters.Queue, filters.Since, filters.Until).
+		Scan(&ratings).Error
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the rating history: %v", err)
+	}
+
+	return ratings, nil
+}
+
+// GetPlayerRatingPeaks returns the highest rating of a player on each season of a queue.
+// The seasons follow the calendar year, the ranked reset happens in January.
+func (ps *playerRepository) GetPlayerRatingPeaks(ctx context.Context, playerId uint, queue string) ([]RatingPeak, error) {
+	var peaks []RatingPeak
+
+	err := ps.db.WithContext(ctx).Raw(`
+	SELECT DISTINCT ON (season)
+		EXTRACT(YEAR FROM fetch_time AT TIME ZONE 'UTC')::INT AS season,
+		tier,
+		rank,
+		league_points,
+		numeric_score,
+		fetch_time
+		FROM rating_entries
+		WHERE player_id = ? AND queue = ?
+		ORDER BY season DESC, numeric_score DESC, fetch_time
+	`, playerId, queue).Scan(&peaks).Error
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the rating peaks: %v", err)
+	}
+
+	return peaks, nil
+}
+
+// GetPlayerDuoMatches returns the teammates of each recent match of a player, grouped by teammate.
+func (ps *playerRepository) GetPlayerDuoMatches(ctx context.Context, filters *filters.PlayerDuoFilter) ([]DuoMatch, error) {
+	if filters == nil {
+		return nil, fmt.Errorf(messages.FiltersNotNil)
+	}
+	var matches []DuoMatch
+
+	queueFilter := ""
+	args := []any{filters.PlayerId, filters.Since, filters.Since}
+	if filters.Queue != 0 {
+		queueFilter = "AND mi.queue_id = ?"
+		args = append(args, filters.Queue)
+	}
+	args = append(args, filters.PlayerId)
+
+	err := ps.db.WithContext(ctx).Raw(`
+	WITH player_matches AS (
+		SELECT
+			ms.match_id,
+			ms.match_start,
+			ms.team_id,
+			ms.win,
+			ROW_NUMBER() OVER (ORDER BY ms.match_start, ms.match_id) AS match_index
+		FROM match_stats ms
+		JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
+		WHERE ms.player_id = ?
+		AND ms.match_start >= ? AND mi.match_start >= ?
+		`+queueFilter+`
+	)
+	SELECT
+		pm.match_index,
+		pm.win,
+		pi.id AS partner_id,
+		pi.riot_id_game_name,
+		pi.riot_id_tagline,
+		pi.profile_icon
+	FROM player_matches pm
+	JOIN match_stats ms ON ms.match_id = pm.match_id
+		AND ms.match_start = pm.match_start
+		AND ms.team_id = pm.team_id
+		AND ms.player_id <> ?
+	JOIN player_infos pi ON pi.id = ms.player_id
+	ORDER BY pi.id, pm.match_index
+	`, args...).Scan(&matches).Error
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the duo matches: %v", err)
+	}
+
+	return matches, nil
+}
+
+// GetSharedMatches returns the participations of the players on the matches that more than one of them played.
+// Uses the same matches of the player stats, ordered by match.
+func (ps *playerRepository) GetSharedMatches(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]SharedMatchParticipant, error) {
+	var participants []SharedMatchParticipant
+
+	patchFilter := ""
+	args := []any{playerIds, since}
+	if patch != "" {
+		patchFilter = "AND mi.patch = ?"
+		args = append(args, patch)
+	}
+	args = append(args, playerIds, since)
+
+	err := ps.db.WithContext(ctx).Raw(`
+	WITH shared_matches AS (
+		SELECT ms.match_id, ms.match_start
+		FROM match_stats ms
+		JOIN match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
+		WHERE ms.player_id IN ?
+		AND ms.match_start >= ?
+		AND mi.queue_id != 1700
+		`+patchFilter+`
+		GROUP BY ms.match_id, ms.match_start
+		HAVING COUNT(*) > 1
+	)
+	SELECT
+		ms.match_id,
+		ms.player_id,
+		ms.team_id,
+		ms.win
+	FROM match_stats ms
+	JOIN shared_matches sm ON sm.match_id = ms.match_id AND sm.match_start = ms.match_start
+	WHERE ms.player_id IN ?
+	AND ms.match_start >= ?
+	ORDER BY ms.match_id, ms.player_id
+	`, args...).Scan(&participants).Error
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the shared matches: %v", err)
+	}
+
+	return participants, nil
+}
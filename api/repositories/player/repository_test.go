@@ -7,6 +7,7 @@ import (
 	"goleague/pkg/database/models"
 	"goleague/pkg/messages"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -211,3 +212,242 @@ func TestGetPlayerById(t *testing.T) {
 		assert.Equal(t, tt.returnData.Data, result)
 	}
 }
+
+func TestGetPlayerStatsLaningDiffs(t *testing.T) {
+	db, cleanup := testutil.NewTestConnection(t)
+	defer cleanup()
+
+	repository := NewPlayerRepository(db)
+
+	seedPlayerTestData(t, db)
+	seedLaningTestData(t, db)
+
+	brtt, brtt2 := uint(1), uint(2)
+	tests := []struct {
+		name       string
+		filters    *filters.PlayerStatsFilter
+		returnData *testutil.OperationRestult[*RawPlayerStatsStruct]
+		setupFunc  func(db *gorm.DB)
+	}{
+		{
+			name:       "nilfilter",
+			filters:    nil,
+			returnData: testutil.NewErrorResult[*RawPlayerStatsStruct](messages.FiltersNotNil),
+		},
+		{
+			name:       "laneahead",
+			filters:    &filters.PlayerStatsFilter{PlayerId: &brtt},
+			returnData: testutil.NewSuccessResult(getLaningExpectedStats(brtt, 3, 66.67, 1)),
+		},
+		{
+			name:       "lanebehind",
+			filters:    &filters.PlayerStatsFilter{PlayerId: &brtt2},
+			returnData: testutil.NewSuccessResult(getLaningExpectedStats(brtt2, 2, 50, -1)),
+		},
+		{
+			name:       "duplicatedopponent",
+			filters:    &filters.PlayerStatsFilter{PlayerId: &brtt},
+			returnData: testutil.NewSuccessResult(getLaningExpectedStats(brtt, 3, 66.67, 1)),
+			setupFunc: func(db *gorm.DB) {
+				seedDuplicatedLaneOpponent(t, db)
+			},
+		},
+		{
+			name:       "dbconnectionerr",
+			filters:    &filters.PlayerStatsFilter{PlayerId: &brtt},
+			returnData: testutil.NewErrorResult[*RawPlayerStatsStruct]("sql: database is closed"),
+			setupFunc: func(db *gorm.DB) {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.setupFunc != nil {
+			tt.setupFunc(db)
+			sqlDb, _ := db.DB()
+			defer sqlDb.Conn(context.Background())
+		}
+
+		result, err := repository.GetPlayerStats(context.Background(), tt.filters)
+
+		if tt.returnData.Err != nil {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.returnData.Err.Error())
+			assert.Nil(t, result)
+			continue
+		}
+
+		assert.NoError(t, err)
+
+		var overall *RawPlayerStatsStruct
+		for i := range result {
+			if result[i].AggregationLevel == "overall" {
+				overall = &result[i]
+			}
+		}
+
+		assert.Equal(t, tt.returnData.Data, overall)
+	}
+}
+
+func TestGetPlayerMatchHistoryEntries(t *testing.T) {
+	db, cleanup := testutil.NewTestConnection(t)
+	defer cleanup()
+
+	repository := NewPlayerRepository(db)
+
+	seedPlayerTestData(t, db)
+	tied := seedMatchHistoryTestData(t, db)
+
+	brtt := uint(1)
+	tests := []struct {
+		name       string
+		filters    *filters.PlayerMatchHistoryFilter
+		returnData *testutil.OperationRestult[[]uint]
+		setupFunc  func(db *gorm.DB)
+	}{
+		{
+			name:       "nilfilter",
+			filters:    nil,
+			returnData: testutil.NewErrorResult[[]uint](messages.FiltersNotNil),
+		},
+		{
+			// The extra match signals the next page.
+			name:       "firstpage",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 2},
+			returnData: testutil.NewSuccessResult([]uint{4, 3, 2}),
+		},
+		{
+			name:       "lastpage",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 10},
+			returnData: testutil.NewSuccessResult([]uint{4, 3, 2, 1}),
+		},
+		{
+			// The tied match with the lower id is still after the cursor.
+			name:       "cursorontie",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 2, Cursor: &filters.MatchHistoryCursor{MatchStart: tied, MatchId: 3}},
+			returnData: testutil.NewSuccessResult([]uint{2, 1}),
+		},
+		{
+			name:       "cursorbeforetie",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 1, Cursor: &filters.MatchHistoryCursor{MatchStart: tied.Add(time.Hour), MatchId: 4}},
+			returnData: testutil.NewSuccessResult([]uint{3, 2}),
+		},
+		{
+			name:       "offsetpage",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 2, Page: 1},
+			returnData: testutil.NewSuccessResult([]uint{2, 1}),
+		},
+		{
+			name:       "dbconnectionerr",
+			filters:    &filters.PlayerMatchHistoryFilter{PlayerId: &brtt, PageSize: 2},
+			returnData: testutil.NewErrorResult[[]uint]("sql: database is closed"),
+			setupFunc: func(db *gorm.DB) {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.setupFunc != nil {
+			tt.setupFunc(db)
+			sqlDb, _ := db.DB()
+			defer sqlDb.Conn(context.Background())
+		}
+
+		result, err := repository.GetPlayerMatchHistoryEntries(context.Background(), tt.filters)
+
+		if tt.returnData.Err != nil {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.returnData.Err.Error())
+			assert.Nil(t, result)
+			continue
+		}
+
+		assert.NoError(t, err)
+
+		ids := make([]uint, len(result))
+		for i, entry := range result {
+			ids[i] = entry.Id
+		}
+		assert.Equal(t, tt.returnData.Data, ids)
+	}
+}
+
+func TestGetSharedMatches(t *testing.T) {
+	db, cleanup := testutil.NewTestConnection(t)
+	defer cleanup()
+
+	repository := NewPlayerRepository(db)
+
+	seedPlayerTestData(t, db)
+	since := seedSharedMatchesTestData(t, db)
+
+	brttMatches := []SharedMatchParticipant{
+		{MatchId: 1, PlayerId: 1, TeamId: 100, Win: true},
+		{MatchId: 1, PlayerId: 2, TeamId: 100, Win: true},
+		{MatchId: 2, PlayerId: 1, TeamId: 100, Win: false},
+		{MatchId: 2, PlayerId: 2, TeamId: 200, Win: true},
+	}
+
+	tests := []struct {
+		name       string
+		playerIds  []uint
+		patch      string
+		returnData *testutil.OperationRestult[[]SharedMatchParticipant]
+		setupFunc  func(db *gorm.DB)
+	}{
+		{
+			name:      "nopatch",
+			playerIds: []uint{1, 2},
+			returnData: testutil.NewSuccessResult(append(brttMatches,
+				SharedMatchParticipant{MatchId: 5, PlayerId: 1, TeamId: 200, Win: true},
+				SharedMatchParticipant{MatchId: 5, PlayerId: 2, TeamId: 100, Win: false},
+			)),
+		},
+		{
+			name:       "patch",
+			playerIds:  []uint{1, 2},
+			patch:      "15.19",
+			returnData: testutil.NewSuccessResult(brttMatches),
+		},
+		{
+			// The players never played together.
+			name:       "notshared",
+			playerIds:  []uint{2, 3},
+			returnData: testutil.NewSuccessResult([]SharedMatchParticipant{}),
+		},
+		{
+			name:       "dbconnectionerr",
+			playerIds:  []uint{1, 2},
+			returnData: testutil.NewErrorResult[[]SharedMatchParticipant]("sql: database is closed"),
+			setupFunc: func(db *gorm.DB) {
+				sqlDB, _ := db.DB()
+				sqlDB.Close()
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if tt.setupFunc != nil {
+			tt.setupFunc(db)
+			sqlDb, _ := db.DB()
+			defer sqlDb.Conn(context.Background())
+		}
+
+		result, err := repository.GetSharedMatches(context.Background(), tt.playerIds, since, tt.patch)
+
+		if tt.returnData.Err != nil {
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.returnData.Err.Error())
+			assert.Nil(t, result)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, tt.returnData.Data, result)
+	}
+}
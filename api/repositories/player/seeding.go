@@ -3,9 +3,11 @@ package repositories
 import (
 	"goleague/pkg/database/models"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func seedPlayerTestData(t *testing.T, db *gorm.DB) map[string]*models.PlayerInfo {
@@ -34,3 +36,159 @@ func seedPlayerTestData(t *testing.T, db *gorm.DB) map[string]*models.PlayerInfo
 
 	return seededData
 }
+
+// seedLaningTestData seeds the lane matches of the players brtt and brtt2, on top of the seeded players.
+// The frames of the second match are every 30 seconds, so its 10 minutes frame is the index 20.
+func seedLaningTestData(t *testing.T, db *gorm.DB) {
+	// Recent matches, inside the default interval of the stats.
+	matchStart := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	matchInfos := []*models.MatchInfo{
+		{ID: 1, QueueId: 420, MatchId: "BR1_1", Region: "BR1", MatchStart: matchStart, MatchDuration: 1800, FrameInterval: 60000},
+		{ID: 2, QueueId: 420, MatchId: "BR1_2", Region: "BR1", MatchStart: matchStart, MatchDuration: 1800, FrameInterval: 30000},
+		{ID: 3, QueueId: 450, MatchId: "BR1_3", Region: "BR1", MatchStart: matchStart, MatchDuration: 1800, FrameInterval: 60000},
+	}
+
+	for _, mi := range matchInfos {
+		err := db.Create(mi).Error
+		require.NoError(t, err)
+	}
+
+	matchStats := []*models.MatchStats{
+		// Match 1 - brtt won the lane against brtt2.
+		{ID: 1, MatchId: 1, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, ParticipantId: 1, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 2, MatchId: 1, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 122, ParticipantId: 6, TeamId: 200, TeamPosition: "TOP", Win: false}},
+
+		// Match 2 - brtt lost the lane against brtt2, ended before the 15 minutes frame.
+		{ID: 3, MatchId: 2, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, ParticipantId: 1, TeamId: 100, TeamPosition: "TOP", Win: false}},
+		{ID: 4, MatchId: 2, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 122, ParticipantId: 6, TeamId: 200, TeamPosition: "TOP", Win: true}},
+
+		// Match 3 - Without positions, so brtt has no lane opponent.
+		{ID: 5, MatchId: 3, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, ParticipantId: 1, TeamId: 100, Win: true}},
+	}
+
+	for _, ms := range matchStats {
+		err := db.Omit(clause.Associations).Create(ms).Error
+		require.NoError(t, err)
+	}
+
+	participantFrames := []*models.ParticipantFrame{
+		// Match 1, ahead on both frames.
+		{MatchStatId: 1, FrameIndex: 10, MatchStart: matchStart, TotalGold: 4000, XP: 4500, MinionsKilled: 80},
+		{MatchStatId: 2, FrameIndex: 10, MatchStart: matchStart, TotalGold: 3500, XP: 4300, MinionsKilled: 70},
+		{MatchStatId: 1, FrameIndex: 15, MatchStart: matchStart, TotalGold: 6500, XP: 7600, MinionsKilled: 115, JungleMinionsKilled: 5},
+		{MatchStatId: 2, FrameIndex: 15, MatchStart: matchStart, TotalGold: 6000, XP: 7400, MinionsKilled: 115},
+
+		// Match 2, behind at 10 minutes. The index 10 is the 5 minutes frame.
+		{MatchStatId: 3, FrameIndex: 20, MatchStart: matchStart, TotalGold: 3000, XP: 4000, MinionsKilled: 60},
+		{MatchStatId: 4, FrameIndex: 20, MatchStart: matchStart, TotalGold: 3400, XP: 4200, MinionsKilled: 75},
+		{MatchStatId: 3, FrameIndex: 10, MatchStart: matchStart, TotalGold: 100000},
+	}
+
+	for _, pf := range participantFrames {
+		err := db.Omit(clause.Associations).Create(pf).Error
+		require.NoError(t, err)
+	}
+}
+
+// seedDuplicatedLaneOpponent adds a second enemy top laner to the first lane match, like the bad positions sent by Riot.
+// Only the first opponent is compared, so the stats of brtt don't change.
+func seedDuplicatedLaneOpponent(t *testing.T, db *gorm.DB) {
+	var matchStart time.Time
+	err := db.Model(&models.MatchInfo{}).Select("match_start").Where("id = ?", 1).Scan(&matchStart).Error
+	require.NoError(t, err)
+
+	stat := &models.MatchStats{ID: 6, MatchId: 1, PlayerId: 3, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 86, ParticipantId: 7, TeamId: 200, TeamPosition: "TOP", Win: false}}
+	err = db.Omit(clause.Associations).Create(stat).Error
+	require.NoError(t, err)
+
+	frame := &models.ParticipantFrame{MatchStatId: 6, FrameIndex: 10, MatchStart: matchStart, TotalGold: 100000}
+	err = db.Omit(clause.Associations).Create(frame).Error
+	require.NoError(t, err)
+}
+
+// seedMatchHistoryTestData seeds the matches of brtt, on top of the seeded players.
+// The matches 2 and 3 started at the same time, so the id breaks the tie. The full history is 4, 3, 2 and 1.
+func seedMatchHistoryTestData(t *testing.T, db *gorm.DB) time.Time {
+	newest := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	tied := newest.Add(-time.Hour)
+
+	matchInfos := []*models.MatchInfo{
+		{ID: 1, QueueId: 420, MatchId: "BR1_1", Region: "BR1", MatchStart: tied.Add(-time.Hour)},
+		{ID: 2, QueueId: 420, MatchId: "BR1_2", Region: "BR1", MatchStart: tied},
+		{ID: 3, QueueId: 420, MatchId: "BR1_3", Region: "BR1", MatchStart: tied},
+		{ID: 4, QueueId: 420, MatchId: "BR1_4", Region: "BR1", MatchStart: newest},
+		{ID: 5, QueueId: 420, MatchId: "BR1_5", Region: "BR1", MatchStart: newest},
+	}
+
+	for _, mi := range matchInfos {
+		err := db.Create(mi).Error
+		require.NoError(t, err)
+	}
+
+	matchStats := []*models.MatchStats{
+		{ID: 1, MatchId: 1, PlayerId: 1, MatchStart: matchInfos[0].MatchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP"}},
+		{ID: 2, MatchId: 2, PlayerId: 1, MatchStart: matchInfos[1].MatchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP"}},
+		{ID: 3, MatchId: 3, PlayerId: 1, MatchStart: matchInfos[2].MatchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP"}},
+		{ID: 4, MatchId: 4, PlayerId: 1, MatchStart: matchInfos[3].MatchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP"}},
+
+		// Only brtt2 played the match 5.
+		{ID: 5, MatchId: 5, PlayerId: 2, MatchStart: matchInfos[4].MatchStart, PlayerData: models.MatchPlayer{ChampionId: 122, TeamId: 200, TeamPosition: "TOP"}},
+	}
+
+	for _, ms := range matchStats {
+		err := db.Omit(clause.Associations).Create(ms).Error
+		require.NoError(t, err)
+	}
+
+	return tied
+}
+
+// seedSharedMatchesTestData seeds the matches of brtt and brtt2, on top of the seeded players.
+// Only the matches 1, 2 and 5 are shared inside the interval, the match 5 is from an older patch.
+func seedSharedMatchesTestData(t *testing.T, db *gorm.DB) time.Time {
+	matchStart := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	oldStart := matchStart.AddDate(0, 0, -60)
+
+	matchInfos := []*models.MatchInfo{
+		{ID: 1, QueueId: 420, MatchId: "BR1_1", Region: "BR1", Patch: "15.19", MatchStart: matchStart},
+		{ID: 2, QueueId: 420, MatchId: "BR1_2", Region: "BR1", Patch: "15.19", MatchStart: matchStart},
+		{ID: 3, QueueId: 420, MatchId: "BR1_3", Region: "BR1", Patch: "15.19", MatchStart: matchStart},
+		{ID: 4, QueueId: 420, MatchId: "BR1_4", Region: "BR1", Patch: "15.10", MatchStart: oldStart},
+		{ID: 5, QueueId: 420, MatchId: "BR1_5", Region: "BR1", Patch: "15.18", MatchStart: matchStart},
+	}
+
+	for _, mi := range matchInfos {
+		err := db.Create(mi).Error
+		require.NoError(t, err)
+	}
+
+	matchStats := []*models.MatchStats{
+		// Match 1 - brtt and brtt2 won together.
+		{ID: 1, MatchId: 1, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 2, MatchId: 1, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 122, TeamId: 100, TeamPosition: "JUNGLE", Win: true}},
+
+		// Match 2 - brtt lost against brtt2.
+		{ID: 3, MatchId: 2, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP", Win: false}},
+		{ID: 4, MatchId: 2, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 122, TeamId: 200, TeamPosition: "TOP", Win: true}},
+
+		// Match 3 - brtt played with minerva, who isn't compared.
+		{ID: 5, MatchId: 3, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 6, MatchId: 3, PlayerId: 3, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 64, TeamId: 100, TeamPosition: "JUNGLE", Win: true}},
+
+		// Match 4 - Shared before the interval.
+		{ID: 7, MatchId: 4, PlayerId: 1, MatchStart: oldStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 100, TeamPosition: "TOP", Win: true}},
+		{ID: 8, MatchId: 4, PlayerId: 2, MatchStart: oldStart, PlayerData: models.MatchPlayer{ChampionId: 122, TeamId: 200, TeamPosition: "TOP", Win: false}},
+
+		// Match 5 - brtt won against brtt2 on the older patch.
+		{ID: 9, MatchId: 5, PlayerId: 1, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 266, TeamId: 200, TeamPosition: "TOP", Win: true}},
+		{ID: 10, MatchId: 5, PlayerId: 2, MatchStart: matchStart, PlayerData: models.MatchPlayer{ChampionId: 122, TeamId: 100, TeamPosition: "TOP", Win: false}},
+	}
+
+	for _, ms := range matchStats {
+		err := db.Omit(clause.Associations).Create(ms).Error
+		require.NoError(t, err)
+	}
+
+	return matchStart.AddDate(0, 0, -30)
+}
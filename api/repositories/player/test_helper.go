@@ -25,3 +25,27 @@ func buildPartialPlayer(full *models.PlayerInfo) *models.PlayerInfo {
 		Region:         full.Region,
 	}
 }
+
+// getLaningExpectedStats returns the overall stats of the seeded lane, with the differences from the side of the player.
+// Only the frames of the minute are used, so the 15 minutes differences come from the first match alone.
+func getLaningExpectedStats(playerId uint, matches int, winRate float32, side float32) *RawPlayerStatsStruct {
+	return &RawPlayerStatsStruct{
+		PlayerId:         playerId,
+		Matches:          matches,
+		QueueId:          -1,
+		TeamPosition:     "ALL",
+		ChampionId:       -1,
+		WinRate:          winRate,
+		GoldDiffAt10:     float32Pointer(50 * side),
+		XpDiffAt10:       float32Pointer(0),
+		CsDiffAt10:       float32Pointer(-2.5 * side),
+		GoldDiffAt15:     float32Pointer(500 * side),
+		XpDiffAt15:       float32Pointer(200 * side),
+		CsDiffAt15:       float32Pointer(5 * side),
+		AggregationLevel: "overall",
+	}
+}
+
+func float32Pointer(value float32) *float32 {
+	return &value
+}
@@ -0,0 +1,80 @@
+package repositories
+
+import (
+	"context"
+	"goleague/pkg/database/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// StatusRepository is the public interface for accessing the fetcher status data.
+type StatusRepository interface {
+	GetCrawlCursors(ctx context.Context) ([]models.LeagueCrawlCursor, error)
+	GetCrawlCycles(ctx context.Context) ([]models.LeagueCrawlCycle, error)
+	GetFailureReasons(ctx context.Context) ([]FailureReasonCount, error)
+}
+
+// FailureReasonCount is the aggregation of the failed matches of a given reason.
+type FailureReasonCount struct {
+	Reason        string
+	Total         int64
+	Permanent     int64
+	Attempts      int64
+	LastFailureAt time.Time
+}
+
+// statusRepository repository structure.
+type statusRepository struct {
+	db *gorm.DB
+}
+
+// NewStatusRepository creates a status repository.
+func NewStatusRepository(db *gorm.DB) StatusRepository {
+	return &statusRepository{db: db}
+}
+
+// GetCrawlCursors returns the league crawl cursors of every sub region.
+func (sr *statusRepository) GetCrawlCursors(ctx context.Context) ([]models.LeagueCrawlCursor, error) {
+	var cursors []models.LeagueCrawlCursor
+	if err := sr.db.WithContext(ctx).
+		Order("region, queue, tier, rank").
+		Find(&cursors).Error; err != nil {
+		return nil, err
+	}
+
+	return cursors, nil
+}
+
+// GetCrawlCycles returns the last completed league cycle of every sub region.
+func (sr *statusRepository) GetCrawlCycles(ctx context.Context) ([]models.LeagueCrawlCycle, error) {
+	var cycles []models.LeagueCrawlCycle
+	if err := sr.db.WithContext(ctx).
+		Order("region").
+		Find(&cycles).Error; err != nil {
+		return nil, err
+	}
+
+	return cycles, nil
+}
+
+// GetFailureReasons returns the failed matches grouped by reason, most frequent first.
+func (sr *statusRepository) GetFailureReasons(ctx context.Context) ([]FailureReasonCount, error) {
+	var reasons []FailureReasonCount
+	if err := sr.db.WithContext(ctx).
+		Model(&models.FailedMatch{}).
+		Select(`
+			reason,
+			COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE permanent) AS permanent,
+			SUM(attempts) AS attempts,
+			MAX(updated_at) AS last_failure_at
+		`).
+		Group("reason").
+		Order("total DESC, reason").
+		Scan(&reasons).Error; err != nil {
+		return nil, err
+	}
+
+	return reasons, nil
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"goleague/api/filters"
+	"goleague/internal/testutil"
+	"testing"
+)
+
+func BenchmarkGetTierlist(b *testing.B) {
+	db, cleanup := testutil.NewTestConnection(b)
+	defer cleanup()
+
+	testutil.SeedBenchmarkMatches(b, db, testutil.BenchmarkMatchCount())
+	repository := NewTierlistRepository(db)
+
+	benchmarks := []struct {
+		name    string
+		filters *filters.TierlistFilter
+	}{
+		{
+			name:    "defaultperiod",
+			filters: filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420}),
+		},
+		{
+			name:    "abovegold",
+			filters: filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420, Tier: "GOLD", Rank: "I", AboveTier: true}),
+		},
+		{
+			name:    "unbounded",
+			filters: &filters.TierlistFilter{Queue: 420},
+		},
+	}
+
+	for _, bb := range benchmarks {
+		b.Run(bb.name, func(b *testing.B) {
+			for b.Loop() {
+				if _, err := repository.GetTierlist(context.Background(), bb.filters); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
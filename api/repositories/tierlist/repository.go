@@ -34,6 +34,7 @@ type TierlistResult struct {
 	PickRate     float64
 	TeamPosition string
 	WinRate      float64
+	Wins         int
 }
 
 // GetTierlist is the only necessary function for the tierlist.
@@ -67,6 +68,41 @@ func (ts *tierlistRepository) GetTierlist(ctx context.Context, filters *filters.
 		singleQueryArgs = append(singleQueryArgs, lower, higher)
 	}
 
+	if filters.Patch != "" {
+		whereConditions = append(whereConditions, "mi.patch = ?")
+		singleQueryArgs = append(singleQueryArgs, filters.Patch)
+	}
+
+	if filters.Region != "" {
+		whereConditions = append(whereConditions, "mi.region = ?")
+		singleQueryArgs = append(singleQueryArgs, filters.Region)
+	}
+
+	// Bound the matches by date, the stats are only scanned on the partitions of the period.
+	var statsConditions string
+	var statsArgs []any
+	if !filters.Since.IsZero() {
+		whereConditions = append(whereConditions, "mi.match_start >= ?")
+		singleQueryArgs = append(singleQueryArgs, filters.Since)
+
+		statsConditions += " AND ms.match_start >= ?"
+		statsArgs = append(statsArgs, filters.Since)
+	}
+
+	if !filters.Until.IsZero() {
+		whereConditions = append(whereConditions, "mi.match_start < ?")
+		singleQueryArgs = append(singleQueryArgs, filters.Until)
+
+		statsConditions += " AND ms.match_start < ?"
+		statsArgs = append(statsArgs, filters.Until)
+	}
+
+	// The role only filters the picks, the bans and total matches are the same for every role.
+	if filters.Role != "" {
+		statsConditions += " AND ms.team_position = ?"
+		statsArgs = append(statsArgs, filters.Role)
+	}
+
 	// Format the WHERE clause.
 	whereClause := ""
 	if len(whereConditions) > 0 {
@@ -79,13 +115,24 @@ func (ts *tierlistRepository) GetTierlist(ctx context.Context, filters *filters.
 	if slices.Contains(queuevalues.QueuesWithPositions, defaultQueue) {
 		positionFix += " AND ms.team_position != ''"
 	}
+	positionFix += statsConditions
+
+	// Without a minimum sample, hide the champions picked on less than 0.5% of the position games.
+	sampleCondition := "(cs.pick_count * 100) / cs.position_total > 0.5"
+	var sampleArgs []any
+	if filters.MinGames > 0 {
+		sampleCondition = "cs.pick_count >= ?"
+		sampleArgs = append(sampleArgs, filters.MinGames)
+	}
 
 	args := []any{}
 	// Append the single query args 3 times.
 	// Three for the CTEs and one for the main query.
 	args = append(args, singleQueryArgs...)
+	args = append(args, statsArgs...)
 	args = append(args, singleQueryArgs...)
 	args = append(args, singleQueryArgs...)
+	args = append(args, sampleArgs...)
 
 	// Construct CTE subqueries with proper WHERE clause placement.
 	// Should have only 6 possible values, 5 from normal queue and empty for Aram.
@@ -100,7 +147,7 @@ func (ts *tierlistRepository) GetTierlist(ctx context.Context, filters *filters.
 		FROM
 			match_stats ms
 		JOIN 
-			match_infos mi ON mi.id = ms.match_id
+			match_infos mi ON mi.id = ms.match_id AND mi.match_start = ms.match_start
 		` + positionFix + `
 		GROUP BY ms.champion_id, ms.team_position
 	)
@@ -130,6 +177,7 @@ func (ts *tierlistRepository) GetTierlist(ctx context.Context, filters *filters.
 	mainQuery := `
 	SELECT
 		cs.pick_count,
+		cs.wins,
     	cs.champion_id,
     	cs.team_position,
     	ROUND((cs.wins * 100.0) / cs.pick_count, 2) AS win_rate,
@@ -141,8 +189,8 @@ func (ts *tierlistRepository) GetTierlist(ctx context.Context, filters *filters.
 	LEFT JOIN
 		champion_bans cb ON cs.champion_id = cb.champion_id
 	CROSS JOIN total_matches tm
-	WHERE (cs.pick_count * 100) / cs.position_total > 0.5
-	ORDER BY win_rate DESC
+	WHERE ` + sampleCondition + `
+	ORDER BY win_rate DESC, cs.pick_count DESC
     `
 	// Combine all parts of the query.
 	query := championStatsCTE + championBansCTE + totalMatchesCTE + mainQuery
@@ -5,6 +5,7 @@ import (
 	"goleague/api/filters"
 	"goleague/internal/testutil"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
@@ -22,6 +23,10 @@ func TestGetTierlist(t *testing.T) {
 	repository := NewTierlistRepository(db)
 
 	seedTestData(t, db)
+
+	// The seeded matches started an hour ago.
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
 	tests := []struct {
 		name       string
 		filters    *filters.TierlistFilter
@@ -43,6 +48,41 @@ func TestGetTierlist(t *testing.T) {
 			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420, Tier: "GOLD", Rank: "I", AboveTier: true}),
 			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "allabovegold")),
 		},
+		{
+			name:       "currentpatch",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{Patch: "15.19"}),
+			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "nofilters")),
+		},
+		{
+			name:       "onlyutility",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{Role: "utility"}),
+			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "onlyutility")),
+		},
+		{
+			name:       "sameregion",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{Region: "br1"}),
+			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "nofilters")),
+		},
+		{
+			name:       "otherregion",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{Region: "kr"}),
+			returnData: testutil.NewSuccessResult([]*TierlistResult{}),
+		},
+		{
+			name:       "mingames",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{MinGames: 8}),
+			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "mingames")),
+		},
+		{
+			name:       "insideperiod",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{From: today.AddDate(0, 0, -1), To: today}),
+			returnData: testutil.NewSuccessResult(getTierlistExpectedResult(t, "nofilters")),
+		},
+		{
+			name:       "beforeperiod",
+			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{From: today.AddDate(0, 0, 1), To: today.AddDate(0, 0, 2)}),
+			returnData: testutil.NewSuccessResult([]*TierlistResult{}),
+		},
 		{
 			name:       "dbconnectionerr",
 			filters:    filters.NewTierlistFilter(filters.TierlistQueryParams{}),
@@ -69,6 +109,12 @@ func TestGetTierlist(t *testing.T) {
 			continue
 		}
 
+		if len(tt.returnData.Data) == 0 {
+			assert.NoError(t, err)
+			assert.Empty(t, result)
+			continue
+		}
+
 		assert.Equal(t, tt.returnData.Data, result)
 	}
 }
@@ -12,11 +12,39 @@ func getTierlistExpectedResult(t *testing.T, testName string) []*TierlistResult
 		return getTierlistAllGold()
 	case "allabovegold":
 		return getTierlistAllAboveGold()
+	case "onlyutility":
+		return getTierlistOnlyPosition("UTILITY")
+	case "mingames":
+		return getTierlistMinGames(8)
 	}
 
 	return nil
 }
 
+// The role only removes the other positions, the rates are calculated by position.
+func getTierlistOnlyPosition(position string) []*TierlistResult {
+	var results []*TierlistResult
+	for _, result := range getTierlistNoFilters() {
+		if result.TeamPosition == position {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
+// The minimum of games replaces the pick rate sample, only removing the champions below it.
+func getTierlistMinGames(minGames int) []*TierlistResult {
+	var results []*TierlistResult
+	for _, result := range getTierlistNoFilters() {
+		if result.PickCount >= minGames {
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
 func getTierlistNoFilters() []*TierlistResult {
 	return []*TierlistResult{
 		{
@@ -27,6 +55,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     12.5,
 			TeamPosition: "MIDDLE",
 			WinRate:      100,
+			Wins:         1,
 		},
 		{
 			BanCount:     4,
@@ -36,6 +65,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "BOTTOM",
 			WinRate:      75,
+			Wins:         6,
 		},
 		{
 			BanCount:     2,
@@ -45,6 +75,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     42.86,
 			TeamPosition: "JUNGLE",
 			WinRate:      66.67,
+			Wins:         2,
 		},
 		{
 			BanCount:     0,
@@ -54,6 +85,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "TOP",
 			WinRate:      62.5,
+			Wins:         5,
 		},
 		{
 			BanCount:     0,
@@ -63,6 +95,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "UTILITY",
 			WinRate:      62.5,
+			Wins:         5,
 		},
 		{
 			BanCount:     0,
@@ -72,6 +105,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     87.5,
 			TeamPosition: "MIDDLE",
 			WinRate:      57.14,
+			Wins:         4,
 		},
 		{
 			BanCount:     0,
@@ -81,6 +115,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     42.86,
 			TeamPosition: "JUNGLE",
 			WinRate:      0,
+			Wins:         0,
 		},
 		{
 			BanCount:     0,
@@ -90,6 +125,7 @@ func getTierlistNoFilters() []*TierlistResult {
 			PickRate:     14.29,
 			TeamPosition: "JUNGLE",
 			WinRate:      0,
+			Wins:         0,
 		},
 	}
 }
@@ -104,6 +140,7 @@ func getTierlistAllGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "UTILITY",
 			WinRate:      100,
+			Wins:         2,
 		},
 		{
 			BanCount:     1,
@@ -113,6 +150,7 @@ func getTierlistAllGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "BOTTOM",
 			WinRate:      50,
+			Wins:         1,
 		},
 		{
 			BanCount:     0,
@@ -122,6 +160,7 @@ func getTierlistAllGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "TOP",
 			WinRate:      50,
+			Wins:         1,
 		},
 		{
 			BanCount:     1,
@@ -131,6 +170,7 @@ func getTierlistAllGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "JUNGLE",
 			WinRate:      50,
+			Wins:         1,
 		},
 		{
 			BanCount:     0,
@@ -140,6 +180,7 @@ func getTierlistAllGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "MIDDLE",
 			WinRate:      50,
+			Wins:         1,
 		},
 	}
 }
@@ -154,6 +195,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     14.29,
 			TeamPosition: "MIDDLE",
 			WinRate:      100,
+			Wins:         1,
 		},
 		{
 			BanCount:     4,
@@ -163,6 +205,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "BOTTOM",
 			WinRate:      85.71,
+			Wins:         6,
 		},
 		{
 			BanCount:     0,
@@ -172,6 +215,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     85.71,
 			TeamPosition: "MIDDLE",
 			WinRate:      66.67,
+			Wins:         4,
 		},
 		{
 			BanCount:     0,
@@ -181,6 +225,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "UTILITY",
 			WinRate:      57.14,
+			Wins:         4,
 		},
 		{
 			BanCount:     0,
@@ -190,6 +235,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     100,
 			TeamPosition: "TOP",
 			WinRate:      57.14,
+			Wins:         4,
 		},
 		{
 			BanCount:     1,
@@ -199,6 +245,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     33.33,
 			TeamPosition: "JUNGLE",
 			WinRate:      50,
+			Wins:         1,
 		},
 		{
 			BanCount:     0,
@@ -208,6 +255,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     50,
 			TeamPosition: "JUNGLE",
 			WinRate:      0,
+			Wins:         0,
 		},
 		{
 			BanCount:     0,
@@ -217,6 +265,7 @@ func getTierlistAllAboveGold() []*TierlistResult {
 			PickRate:     16.67,
 			TeamPosition: "JUNGLE",
 			WinRate:      0,
+			Wins:         0,
 		},
 	}
 }
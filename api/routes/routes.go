@@ -2,8 +2,11 @@ package routes
 
 import (
 	"goleague/api/handlers"
+	"goleague/api/middleware"
+	"goleague/pkg/metrics"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // Router wrapper.
@@ -13,7 +16,12 @@ type Router struct {
 }
 
 // NewRouter creates a router engine with a API group.
+// The metrics are exposed outside of the API group.
 func NewRouter(engine *gin.Engine) *Router {
+	engine.Use(otelgin.Middleware("goleague-api"))
+	engine.Use(middleware.Metrics())
+	engine.GET("/metrics", gin.WrapH(metrics.Handler()))
+
 	return &Router{
 		api:    engine.Group("/api/v1"),
 		Engine: engine,
@@ -32,6 +40,12 @@ func (r *Router) SetupRoutes(handlerList ...any) {
 			r.registerMatchHandler(handler)
 		case *handlers.ChampionHandler:
 			r.registerChampionHandler(handler)
+		case *handlers.LeaderboardHandler:
+			r.registerLeaderboardHandler(handler)
+		case *handlers.PatchHandler:
+			r.registerPatchHandler(handler)
+		case *handlers.StatusHandler:
+			r.registerStatusHandler(handler)
 		}
 	}
 }
@@ -42,6 +56,18 @@ func (r *Router) registerChampionHandler(handler *handlers.ChampionHandler) {
 	{
 		champion.GET("", handler.GetAllChampions)
 		champion.GET(":championId", handler.GetChampionData)
+		champion.GET(":championId/builds", handler.GetChampionBuilds)
+		champion.GET(":championId/matchups", handler.GetChampionMatchups)
+		champion.GET(":championId/skills", handler.GetChampionSkills)
+		champion.GET(":championId/synergies", handler.GetChampionSynergies)
+	}
+}
+
+// registerLeaderboardHandler implements the leaderboard routes.
+func (r *Router) registerLeaderboardHandler(handler *handlers.LeaderboardHandler) {
+	leaderboard := r.api.Group("/leaderboard")
+	{
+		leaderboard.GET(":region", handler.GetLeaderboard)
 	}
 }
 
@@ -53,19 +79,40 @@ func (r *Router) registerMatchHandler(handler *handlers.MatchHandler) {
 	}
 }
 
+// registerPatchHandler implements the patch routes.
+func (r *Router) registerPatchHandler(handler *handlers.PatchHandler) {
+	patches := r.api.Group("/patches")
+	{
+		patches.GET("", handler.GetPatches)
+	}
+}
+
 // registerPlayerHandler implements the player routes.
 func (r *Router) registerPlayerHandler(handler *handlers.PlayerHandler) {
 	player := r.api.Group("/player")
 	{
+		player.GET("compare", handler.GetPlayerComparison)
 		player.GET("search", handler.GetPlayerSearch)
+		player.GET(":region/:gameName/:gameTag/duos", handler.GetPlayerDuoPartners)
 		player.GET(":region/:gameName/:gameTag/info", handler.GetPlayerInfo)
 		player.GET(":region/:gameName/:gameTag/matches", handler.GetPlayerMatchHistory)
+		player.GET(":region/:gameName/:gameTag/rating-history", handler.GetPlayerRatingHistory)
 		player.GET(":region/:gameName/:gameTag/stats", handler.GetPlayerStats)
+		player.GET(":region/:gameName/:gameTag/summary", handler.GetPlayerSummary)
 		player.POST(":region/:gameName/:gameTag", handler.ForceFetchPlayer)
 		player.POST(":region/:gameName/:gameTag/matches", handler.ForceFetchPlayerMatchHistory)
 	}
 }
 
+// registerStatusHandler implements the status routes.
+func (r *Router) registerStatusHandler(handler *handlers.StatusHandler) {
+	status := r.api.Group("/status")
+	{
+		status.GET("crawl", handler.GetCrawlStatus)
+		status.GET("failed-matches", handler.GetFailedMatches)
+	}
+}
+
 // registerTierlistHandler implements the tierlist routes.
 func (r *Router) registerTierlistHandler(handler *handlers.TierlistHandler) {
 	tierlist := r.api.Group("/tierlist")
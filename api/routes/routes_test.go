@@ -1,6 +1,9 @@
 package routes
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"goleague/api/handlers"
@@ -31,9 +34,30 @@ func TestSetupRoutes(t *testing.T) {
 	playerHandler := &handlers.PlayerHandler{}
 	matchHandler := &handlers.MatchHandler{}
 	championHandler := &handlers.ChampionHandler{}
+	statusHandler := &handlers.StatusHandler{}
+	patchHandler := &handlers.PatchHandler{}
+	leaderboardHandler := &handlers.LeaderboardHandler{}
 
-	router.SetupRoutes(tierlistHandler, playerHandler, matchHandler, championHandler)
+	router.SetupRoutes(tierlistHandler, playerHandler, matchHandler, championHandler, statusHandler, patchHandler, leaderboardHandler)
 
 	routes := router.Engine.Routes()
 	assert.Greater(t, len(routes), 0)
 }
+
+func TestMetricsRoute(t *testing.T) {
+	router := setupTestRouter()
+
+	// The route latency is only recorded after the first request finishes.
+	for range 2 {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, "/metrics", nil)
+		router.Engine.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		if strings.Contains(w.Body.String(), "goleague_api_request_duration_seconds") {
+			return
+		}
+	}
+
+	t.Error("the route latency wasn't exposed on the metrics endpoint")
+}
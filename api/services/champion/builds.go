@@ -0,0 +1,229 @@
+package champion
+
+import (
+	"goleague/api/converters"
+	"goleague/api/dto"
+	championrepo "goleague/api/repositories/champion"
+	"goleague/pkg/models/item"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Purchases made before the players leave the fountain, in milliseconds.
+	startingItemsWindow = 60_000
+	// Items without upgrades below this cost are consumables, trinkets or small items.
+	completedItemMinGold = 1000
+	coreItemsCount       = 3
+	// Options returned on each list.
+	buildOptionsLimit = 5
+	// Minimum sample of the highest win rate options, by matches and by percent of the matches.
+	buildMinMatches  = 5
+	buildMinPickRate = 1.0
+)
+
+// Item tag of every boots.
+const bootsTag = "Boots"
+
+// playerBuild is the purchase order of a player, without the undone purchases.
+type playerBuild struct {
+	win       bool
+	purchases []*championrepo.ChampionItemEvent
+}
+
+// buildCount is the number of matches and wins of a set of items, or of a skill order.
+type buildCount struct {
+	ids     []string
+	matches int
+	wins    int
+}
+
+// buildCounter counts the matches of each set of ids.
+type buildCounter map[string]*buildCount
+
+// aggregateBuilds returns the starting items, the first completed items and the boots of the players.
+func aggregateBuilds(result *dto.ChampionBuilds, events []*championrepo.ChampionItemEvent, items map[string]*item.Item) {
+	builds := groupPlayerBuilds(events)
+
+	starting := make(buildCounter)
+	core := make(buildCounter)
+	boots := make(buildCounter)
+
+	for _, build := range builds {
+		var startingIds, coreIds []string
+		var bootsId string
+
+		for _, purchase := range build.purchases {
+			itemId := strconv.Itoa(purchase.ItemId)
+			itemData, exists := items[itemId]
+			if !exists {
+				continue
+			}
+
+			if purchase.Timestamp < startingItemsWindow && itemData.Gold.Total > 0 {
+				startingIds = append(startingIds, itemId)
+			}
+
+			if bootsId == "" && isUpgradedBoots(itemData) {
+				bootsId = itemId
+			}
+
+			if len(coreIds) < coreItemsCount && isCompletedItem(itemData) && !slices.Contains(coreIds, itemId) {
+				coreIds = append(coreIds, itemId)
+			}
+		}
+
+		// The order of the starting items doesn't matter, they are all bought at once.
+		if len(startingIds) > 0 {
+			slices.Sort(startingIds)
+			starting.add(startingIds, build.win)
+		}
+
+		if len(coreIds) == coreItemsCount {
+			core.add(coreIds, build.win)
+		}
+
+		if bootsId != "" {
+			boots.add([]string{bootsId}, build.win)
+		}
+	}
+
+	result.Matches = len(builds)
+	result.StartingItems = starting.options(len(builds), items)
+	result.CoreItems = core.options(len(builds), items)
+	result.Boots = boots.options(len(builds), items)
+}
+
+// groupPlayerBuilds groups the ordered events by player, removing the undone purchases.
+func groupPlayerBuilds(events []*championrepo.ChampionItemEvent) []*playerBuild {
+	var builds []*playerBuild
+	var current *playerBuild
+	var currentId uint64
+
+	for _, event := range events {
+		if current == nil || event.MatchStatId != currentId {
+			current = &playerBuild{win: event.Win}
+			currentId = event.MatchStatId
+			builds = append(builds, current)
+		}
+
+		if event.Action != "ITEM_UNDO" {
+			current.purchases = append(current.purchases, event)
+			continue
+		}
+
+		// The undo of a sell has no item, only the undone purchases are removed.
+		for i := len(current.purchases) - 1; i >= 0; i-- {
+			if current.purchases[i].ItemId == event.ItemId {
+				current.purchases = slices.Delete(current.purchases, i, i+1)
+				break
+			}
+		}
+	}
+
+	return builds
+}
+
+// isCompletedItem checks if the item is the last step of a build path.
+func isCompletedItem(itemData *item.Item) bool {
+	return len(itemData.Into) == 0 &&
+		itemData.Gold.Purchasable &&
+		itemData.Gold.Total >= completedItemMinGold &&
+		!slices.Contains(itemData.Tags, bootsTag)
+}
+
+// isUpgradedBoots checks if the item are boots built from the basic ones.
+func isUpgradedBoots(itemData *item.Item) bool {
+	return slices.Contains(itemData.Tags, bootsTag) && len(itemData.From) > 0
+}
+
+// add counts a match of the set of ids.
+func (bc buildCounter) add(ids []string, win bool) {
+	key := strings.Join(ids, ",")
+	count, exists := bc[key]
+	if !exists {
+		count = &buildCount{ids: ids}
+		bc[key] = count
+	}
+
+	count.matches++
+	if win {
+		count.wins++
+	}
+}
+
+// options returns the most common and the highest win rate sets of items.
+func (bc buildCounter) options(totalMatches int, items map[string]*item.Item) *dto.BuildOptions {
+	mostCommon, highestWinRate := bc.rank(totalMatches)
+
+	return &dto.BuildOptions{
+		MostCommon:     toBuildOptions(mostCommon, totalMatches, items),
+		HighestWinRate: toBuildOptions(highestWinRate, totalMatches, items),
+	}
+}
+
+// rank sorts the sets by matches and by win rate, up to the options limit.
+// The highest win rate only considers the sets with enough matches.
+func (bc buildCounter) rank(totalMatches int) ([]*buildCount, []*buildCount) {
+	counts := make([]*buildCount, 0, len(bc))
+	for _, count := range bc {
+		counts = append(counts, count)
+	}
+
+	// Sort by the ids first, so the ties are always on the same order.
+	sort.Slice(counts, func(i, j int) bool {
+		return strings.Join(counts[i].ids, ",") < strings.Join(counts[j].ids, ",")
+	})
+
+	mostCommon := slices.Clone(counts)
+	sort.SliceStable(mostCommon, func(i, j int) bool {
+		return mostCommon[i].matches > mostCommon[j].matches
+	})
+
+	minMatches := max(buildMinMatches, int(math.Ceil(float64(totalMatches)*buildMinPickRate/100)))
+	var highestWinRate []*buildCount
+	for _, count := range counts {
+		if count.matches >= minMatches {
+			highestWinRate = append(highestWinRate, count)
+		}
+	}
+	sort.SliceStable(highestWinRate, func(i, j int) bool {
+		left := float64(highestWinRate[i].wins) / float64(highestWinRate[i].matches)
+		right := float64(highestWinRate[j].wins) / float64(highestWinRate[j].matches)
+		if left != right {
+			return left > right
+		}
+		return highestWinRate[i].matches > highestWinRate[j].matches
+	})
+
+	return mostCommon[:min(len(mostCommon), buildOptionsLimit)], highestWinRate[:min(len(highestWinRate), buildOptionsLimit)]
+}
+
+// toBuildOptions converts the counts to the options with the item data.
+func toBuildOptions(counts []*buildCount, totalMatches int, items map[string]*item.Item) []*dto.BuildOption {
+	options := make([]*dto.BuildOption, 0, len(counts))
+	for _, count := range counts {
+		option := &dto.BuildOption{
+			Items:    make([]*dto.BuildItem, len(count.ids)),
+			Matches:  count.matches,
+			PickRate: converters.Percentage(count.matches, totalMatches),
+			WinRate:  converters.Percentage(count.wins, count.matches),
+		}
+
+		for i, itemId := range count.ids {
+			itemData := items[itemId]
+			option.Items[i] = &dto.BuildItem{
+				ID:    itemData.ID,
+				Name:  itemData.Name,
+				Image: itemData.Image,
+			}
+		}
+
+		options = append(options, option)
+	}
+
+	return options
+}
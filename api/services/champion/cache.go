@@ -0,0 +1,150 @@
+package champion
+
+import (
+	"context"
+	"encoding/json"
+	"goleague/api/cache"
+	"goleague/api/dto"
+	"goleague/api/filters"
+	"goleague/pkg/metrics"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ChampionStatsMemoryCacheDuration = 15 * time.Minute
+	ChampionStatsRedisCacheDuration  = time.Hour
+	ChampionStatsRedisCacheTimeout   = time.Millisecond * 200
+	championRoleStatsCacheName       = "champion_role_stats"
+	championBuildsCacheName          = "champion_builds"
+	championSkillsCacheName          = "champion_skills"
+	championMatchupsCacheName        = "champion_matchups"
+	championSynergiesCacheName       = "champion_synergies"
+)
+
+type ChampionRedisClient interface {
+	Get(ctx context.Context, key string) (string, error)
+	Set(ctx context.Context, key string, value any, ttl time.Duration) error
+}
+
+// championStats are the cached champion statistics, each one with its own memory cache.
+type championStats interface {
+	[]*dto.ChampionRoleStats | *dto.ChampionBuilds | *dto.ChampionSkills | *dto.ChampionMatchups | *dto.ChampionSynergies
+}
+
+// getCachedStats looks for the statistics on the memory and then on redis.
+// The statistics found on redis are kept on the memory for the next requests.
+func getCachedStats[T championStats](cs *ChampionService, memCache cache.MemCache[T], cacheName string, key string) (T, bool) {
+	if mem := memCache.Get(key); mem != nil {
+		metrics.CacheHit(cacheName, metrics.CacheLayerMemory)
+		return mem, true
+	}
+	metrics.CacheMiss(cacheName, metrics.CacheLayerMemory)
+
+	ctx, cancel := context.WithTimeout(context.Background(), ChampionStatsRedisCacheTimeout)
+	defer cancel()
+
+	var cached T
+	redisCached, err := cs.redis.Get(ctx, key)
+	if err != nil || redisCached == "" || json.Unmarshal([]byte(redisCached), &cached) != nil {
+		metrics.CacheMiss(cacheName, metrics.CacheLayerRedis)
+		return cached, false
+	}
+	metrics.CacheHit(cacheName, metrics.CacheLayerRedis)
+
+	memCache.Set(key, cached, ChampionStatsMemoryCacheDuration)
+	return cached, true
+}
+
+// setCachedStats will set the mem cache and redis cache.
+func setCachedStats[T championStats](cs *ChampionService, memCache cache.MemCache[T], key string, data T) {
+	memCache.Set(key, data, ChampionStatsMemoryCacheDuration)
+
+	if j, err := json.Marshal(data); err == nil {
+		cs.redis.Set(context.Background(), key, string(j), ChampionStatsRedisCacheDuration)
+	}
+}
+
+// getChampionStatsKey generates the cache key of a champion statistic.
+func getChampionStatsKey(cacheName string, championKey int, filters *filters.ChampionStatsFilter) string {
+	var builder strings.Builder
+	builder.WriteString(cacheName + ":champion_" + strconv.Itoa(championKey))
+
+	if filters.Queue != 0 {
+		builder.WriteString(":queue_" + strconv.Itoa(filters.Queue))
+	}
+
+	if filters.Patch != "" {
+		builder.WriteString(":patch_" + filters.Patch)
+	}
+
+	if filters.Role != "" {
+		builder.WriteString(":role_" + filters.Role)
+	}
+
+	if filters.MinRating != 0 {
+		builder.WriteString(":min_rating_" + strconv.Itoa(filters.MinRating))
+	}
+
+	if filters.MaxRating != 0 {
+		builder.WriteString(":max_rating_" + strconv.Itoa(filters.MaxRating))
+	}
+
+	// A window of days moves with the time, so the key has the days and not the date.
+	if filters.Days != 0 {
+		builder.WriteString(":days_" + strconv.Itoa(filters.Days))
+	}
+
+	return builder.String()
+}
+
+// getChampionSynergiesKey generates the cache key of the synergies, with the same filters of the tierlist key.
+func getChampionSynergiesKey(championKey int, filters *filters.TierlistFilter) string {
+	var builder strings.Builder
+	builder.WriteString(championSynergiesCacheName + ":champion_" + strconv.Itoa(championKey))
+
+	if filters.Queue != 0 {
+		builder.WriteString(":queue_" + strconv.Itoa(filters.Queue))
+	}
+
+	if filters.NumericTier != 0 {
+		builder.WriteString(":tier_" + strconv.Itoa(filters.NumericTier))
+	}
+
+	if filters.GetTiersAbove {
+		builder.WriteString(":with_higher_tiers")
+	}
+
+	if filters.Patch != "" {
+		builder.WriteString(":patch_" + filters.Patch)
+	}
+
+	if filters.Region != "" {
+		builder.WriteString(":region_" + filters.Region)
+	}
+
+	if filters.Role != "" {
+		builder.WriteString(":role_" + filters.Role)
+	}
+
+	if filters.MinGames != 0 {
+		builder.WriteString(":min_games_" + strconv.Itoa(filters.MinGames))
+	}
+
+	if filters.Days != 0 {
+		builder.WriteString(":days_" + strconv.Itoa(filters.Days))
+	}
+
+	if filters.CustomPeriod {
+		if !filters.Since.IsZero() {
+			builder.WriteString(":since_" + filters.Since.Format(time.DateOnly))
+		}
+
+		if !filters.Until.IsZero() {
+			builder.WriteString(":until_" + filters.Until.Format(time.DateOnly))
+		}
+	}
+
+	return builder.String()
+}
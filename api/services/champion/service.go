@@ -2,39 +2,334 @@ package champion
 
 import (
 	"context"
+	"fmt"
 	"goleague/api/cache"
+	"goleague/api/converters"
+	"goleague/api/dto"
 	"goleague/api/filters"
+	championrepo "goleague/api/repositories/champion"
+	"goleague/pkg/metrics"
 	"goleague/pkg/models/champion"
+	"sort"
+	"strconv"
 
 	"gorm.io/gorm"
 )
 
 // ChampionService with the  repositories and the gRPC client in case we need to force fetch something (Unlikely).
 type ChampionService struct {
-	db            *gorm.DB
-	championCache cache.ChampionCache
-	memCache      cache.MemCache[*champion.Champion]
+	db                 *gorm.DB
+	championCache      cache.ChampionCache
+	itemCache          cache.ItemCache
+	memCache           cache.MemCache[*champion.Champion]
+	roleStatsMemCache  cache.MemCache[[]*dto.ChampionRoleStats]
+	buildsMemCache     cache.MemCache[*dto.ChampionBuilds]
+	skillsMemCache     cache.MemCache[*dto.ChampionSkills]
+	matchupsMemCache   cache.MemCache[*dto.ChampionMatchups]
+	synergiesMemCache  cache.MemCache[*dto.ChampionSynergies]
+	redis              ChampionRedisClient
+	ChampionRepository championrepo.ChampionRepository
 }
 
 // ChampionServiceDeps is the dependency list for the champion service.
 type ChampionServiceDeps struct {
-	DB            *gorm.DB
-	ChampionCache cache.ChampionCache
-	MemCache      cache.MemCache[*champion.Champion]
+	DB                *gorm.DB
+	ChampionCache     cache.ChampionCache
+	ItemCache         cache.ItemCache
+	MemCache          cache.MemCache[*champion.Champion]
+	RoleStatsMemCache cache.MemCache[[]*dto.ChampionRoleStats]
+	BuildsMemCache    cache.MemCache[*dto.ChampionBuilds]
+	SkillsMemCache    cache.MemCache[*dto.ChampionSkills]
+	MatchupsMemCache  cache.MemCache[*dto.ChampionMatchups]
+	SynergiesMemCache cache.MemCache[*dto.ChampionSynergies]
+	Redis             ChampionRedisClient
 }
 
 // NewChampionService creates a champion service.
 func NewChampionService(deps *ChampionServiceDeps) *ChampionService {
 	return &ChampionService{
-		db:            deps.DB,
-		championCache: deps.ChampionCache,
-		memCache:      deps.MemCache,
+		db:                 deps.DB,
+		championCache:      deps.ChampionCache,
+		itemCache:          deps.ItemCache,
+		memCache:           deps.MemCache,
+		roleStatsMemCache:  deps.RoleStatsMemCache,
+		buildsMemCache:     deps.BuildsMemCache,
+		skillsMemCache:     deps.SkillsMemCache,
+		matchupsMemCache:   deps.MatchupsMemCache,
+		synergiesMemCache:  deps.SynergiesMemCache,
+		redis:              deps.Redis,
+		ChampionRepository: championrepo.NewChampionRepository(deps.DB),
 	}
 }
 
-// Wrapper that just returns the cached champion.
-func (cs *ChampionService) GetChampionData(ctx context.Context, filters *filters.GetChampionDataFilter) (*champion.Champion, error) {
-	return cs.championCache.GetChampionCopy(ctx, filters.ChampionId)
+// GetChampionData returns the cached champion with its stats by position.
+func (cs *ChampionService) GetChampionData(ctx context.Context, filters *filters.GetChampionDataFilter) (*dto.ChampionData, error) {
+	championData, championKey, err := cs.getChampionWithKey(ctx, filters.ChampionId)
+	if err != nil {
+		return nil, err
+	}
+
+	roleStats, err := cs.getChampionRoleStats(ctx, championKey, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.ChampionData{
+		Champion: championData,
+		Patch:    filters.Patch,
+		Stats:    roleStats,
+	}, nil
+}
+
+// getChampionRoleStats returns the cached stats by position, getting them from the repository on a miss.
+func (cs *ChampionService) getChampionRoleStats(ctx context.Context, championKey int, dataFilters *filters.GetChampionDataFilter) ([]*dto.ChampionRoleStats, error) {
+	key := getChampionStatsKey(championRoleStatsCacheName, championKey, &filters.ChampionStatsFilter{
+		Queue: dataFilters.Queue,
+		Patch: dataFilters.Patch,
+		Days:  dataFilters.Days,
+	})
+
+	if cached, ok := getCachedStats(cs, cs.roleStatsMemCache, championRoleStatsCacheName, key); ok {
+		return cached, nil
+	}
+
+	stats, err := cs.ChampionRepository.GetChampionStats(ctx, championKey, dataFilters)
+	if err != nil {
+		metrics.QueryError(championRoleStatsCacheName)
+		return nil, fmt.Errorf("couldn't get the champion stats: %w", err)
+	}
+
+	result := make([]*dto.ChampionRoleStats, len(stats))
+	for i, stat := range stats {
+		result[i] = &dto.ChampionRoleStats{
+			Matches:      stat.Matches,
+			TeamPosition: stat.TeamPosition,
+			WinRate:      stat.WinRate,
+		}
+	}
+
+	setCachedStats(cs, cs.roleStatsMemCache, key, result)
+
+	return result, nil
+}
+
+// GetChampionBuilds returns the starting items, the first completed items and the boots of a champion.
+func (cs *ChampionService) GetChampionBuilds(ctx context.Context, filters *filters.ChampionStatsFilter) (*dto.ChampionBuilds, error) {
+	championData, championKey, err := cs.getChampionWithKey(ctx, filters.ChampionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The builds are aggregated from every item event, so they are only calculated on a cache miss.
+	key := getChampionStatsKey(championBuildsCacheName, championKey, filters)
+	if cached, ok := getCachedStats(cs, cs.buildsMemCache, championBuildsCacheName, key); ok {
+		return cached, nil
+	}
+
+	items, err := cs.itemCache.GetAllItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the items: %w", err)
+	}
+
+	events, err := cs.ChampionRepository.GetChampionItemEvents(ctx, championKey, filters)
+	if err != nil {
+		metrics.QueryError(championBuildsCacheName)
+		return nil, fmt.Errorf("couldn't get the champion item events: %w", err)
+	}
+
+	result := &dto.ChampionBuilds{
+		ChampionId: championData.ID,
+		Patch:      filters.Patch,
+		Role:       filters.Role,
+	}
+	aggregateBuilds(result, events, items)
+
+	setCachedStats(cs, cs.buildsMemCache, key, result)
+
+	return result, nil
+}
+
+// GetChampionSkills returns the max orders and the first levels of a champion.
+func (cs *ChampionService) GetChampionSkills(ctx context.Context, filters *filters.ChampionStatsFilter) (*dto.ChampionSkills, error) {
+	championData, championKey, err := cs.getChampionWithKey(ctx, filters.ChampionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The skill orders are aggregated from every skill event, so they are only calculated on a cache miss.
+	key := getChampionStatsKey(championSkillsCacheName, championKey, filters)
+	if cached, ok := getCachedStats(cs, cs.skillsMemCache, championSkillsCacheName, key); ok {
+		return cached, nil
+	}
+
+	events, err := cs.ChampionRepository.GetChampionSkillEvents(ctx, championKey, filters)
+	if err != nil {
+		metrics.QueryError(championSkillsCacheName)
+		return nil, fmt.Errorf("couldn't get the champion skill events: %w", err)
+	}
+
+	result := &dto.ChampionSkills{
+		ChampionId: championData.ID,
+		Patch:      filters.Patch,
+		Role:       filters.Role,
+	}
+	aggregateSkills(result, events, championData.Spells)
+
+	setCachedStats(cs, cs.skillsMemCache, key, result)
+
+	return result, nil
+}
+
+// GetChampionMatchups returns the performance of a champion against each lane opponent.
+func (cs *ChampionService) GetChampionMatchups(ctx context.Context, filters *filters.ChampionStatsFilter) (*dto.ChampionMatchups, error) {
+	championData, championKey, err := cs.getChampionWithKey(ctx, filters.ChampionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The matchups join the lane opponents and their frames, so they are only calculated on a cache miss.
+	key := getChampionStatsKey(championMatchupsCacheName, championKey, filters)
+	if cached, ok := getCachedStats(cs, cs.matchupsMemCache, championMatchupsCacheName, key); ok {
+		return cached, nil
+	}
+
+	matchups, err := cs.ChampionRepository.GetChampionMatchups(ctx, championKey, filters)
+	if err != nil {
+		metrics.QueryError(championMatchupsCacheName)
+		return nil, fmt.Errorf("couldn't get the champion matchups: %w", err)
+	}
+
+	championsByKey, err := cs.getChampionsByKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the opponent champions: %w", err)
+	}
+
+	result := &dto.ChampionMatchups{
+		ChampionId: championData.ID,
+		Patch:      filters.Patch,
+		Role:       filters.Role,
+		Matchups:   make([]*dto.ChampionMatchup, len(matchups)),
+	}
+
+	for i, matchup := range matchups {
+		opponentKey := strconv.Itoa(matchup.OpponentKey)
+		result.Matchups[i] = &dto.ChampionMatchup{
+			OpponentId:   opponentKey,
+			TeamPosition: matchup.TeamPosition,
+			Matches:      matchup.Matches,
+			WinRate:      matchup.WinRate,
+			GoldDiffAt10: matchup.GoldDiffAt10,
+			XpDiffAt10:   matchup.XpDiffAt10,
+			CsDiffAt10:   matchup.CsDiffAt10,
+			GoldDiffAt15: matchup.GoldDiffAt15,
+			XpDiffAt15:   matchup.XpDiffAt15,
+			CsDiffAt15:   matchup.CsDiffAt15,
+		}
+
+		// Champions released after the last cache revalidation keep the numeric key.
+		if opponent, exists := championsByKey[opponentKey]; exists {
+			result.Matchups[i].OpponentId = opponent.ID
+			result.Matchups[i].OpponentName = opponent.Name
+			result.Matchups[i].OpponentImage = opponent.Image
+		}
+	}
+
+	setCachedStats(cs, cs.matchupsMemCache, key, result)
+
+	return result, nil
+}
+
+// GetChampionSynergies returns the performance of a champion with each ally, compared to the expected.
+func (cs *ChampionService) GetChampionSynergies(ctx context.Context, championId string, filters *filters.TierlistFilter) (*dto.ChampionSynergies, error) {
+	championData, championKey, err := cs.getChampionWithKey(ctx, championId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The synergies pair every pick of the champion's matches, so they are only calculated on a cache miss.
+	key := getChampionSynergiesKey(championKey, filters)
+	if cached, ok := getCachedStats(cs, cs.synergiesMemCache, championSynergiesCacheName, key); ok {
+		return cached, nil
+	}
+
+	synergies, err := cs.ChampionRepository.GetChampionSynergies(ctx, championKey, filters)
+	if err != nil {
+		metrics.QueryError(championSynergiesCacheName)
+		return nil, fmt.Errorf("couldn't get the champion synergies: %w", err)
+	}
+
+	championsByKey, err := cs.getChampionsByKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the ally champions: %w", err)
+	}
+
+	result := &dto.ChampionSynergies{
+		ChampionId: championData.ID,
+		Patch:      filters.Patch,
+		Role:       filters.Role,
+		Synergies:  make([]*dto.ChampionSynergy, len(synergies)),
+	}
+
+	for i, synergy := range synergies {
+		// Each champion adds its distance from an even win rate.
+		expected := synergy.ChampionWinRate + synergy.AllyWinRate - 50
+
+		allyKey := strconv.Itoa(synergy.AllyKey)
+		result.Synergies[i] = &dto.ChampionSynergy{
+			AllyId:          allyKey,
+			AllyPosition:    synergy.AllyPosition,
+			TeamPosition:    synergy.TeamPosition,
+			Matches:         synergy.Matches,
+			WinRate:         synergy.WinRate,
+			ExpectedWinRate: converters.RoundTwoDecimals(expected),
+			Synergy:         converters.RoundTwoDecimals(synergy.WinRate - expected),
+		}
+
+		if ally, exists := championsByKey[allyKey]; exists {
+			result.Synergies[i].AllyId = ally.ID
+			result.Synergies[i].AllyName = ally.Name
+			result.Synergies[i].AllyImage = ally.Image
+		}
+	}
+
+	sort.SliceStable(result.Synergies, func(i, j int) bool {
+		return result.Synergies[i].Synergy > result.Synergies[j].Synergy
+	})
+
+	setCachedStats(cs, cs.synergiesMemCache, key, result)
+
+	return result, nil
+}
+
+// getChampionsByKey returns every cached champion by the numeric key, used by the match stats.
+func (cs *ChampionService) getChampionsByKey(ctx context.Context) (map[string]*champion.Champion, error) {
+	champions, err := cs.championCache.GetAllChampions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	championsByKey := make(map[string]*champion.Champion, len(champions))
+	for _, championData := range champions {
+		championsByKey[championData.NameKey] = championData
+	}
+
+	return championsByKey, nil
+}
+
+// getChampionWithKey returns the cached champion with its numeric key.
+// The match stats reference the champion by the numeric key.
+func (cs *ChampionService) getChampionWithKey(ctx context.Context, championId string) (*champion.Champion, int, error) {
+	championData, err := cs.championCache.GetChampionCopy(ctx, championId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	championKey, err := strconv.Atoi(championData.NameKey)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid key %s for the champion %s: %w", championData.NameKey, championData.ID, err)
+	}
+
+	return championData, championKey, nil
 }
 
 // Wrapper that just returns all cached champions.
@@ -0,0 +1,668 @@
+package champion
+
+import (
+	"context"
+	"errors"
+	"goleague/api/dto"
+	"goleague/api/filters"
+	championrepo "goleague/api/repositories/champion"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"goleague/pkg/models/champion"
+	"goleague/pkg/models/item"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Simple test for asserting that everything is fine with the champion service creation.
+func TestNewChampionService(t *testing.T) {
+	deps := &ChampionServiceDeps{
+		DB: new(gorm.DB),
+	}
+
+	service := NewChampionService(deps)
+	assert.NotNil(t, service)
+	assert.Equal(t, new(gorm.DB), service.db)
+	assert.NotNil(t, service.ChampionRepository)
+}
+
+func TestGetChampionData(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters *filters.GetChampionDataFilter
+
+		mockChampion *testutil.OperationRestult[*champion.Champion]
+		mockStats    *testutil.OperationRestult[[]*championrepo.ChampionRoleStats]
+		cachedStats  []*dto.ChampionRoleStats
+
+		expectedPositions []string
+		expectedError     error
+	}{
+		{
+			name:          "championNotCached",
+			filters:       &filters.GetChampionDataFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewErrorResult[*champion.Champion]("error getting from the database fallback"),
+			expectedError: errors.New("error getting from the database fallback"),
+		},
+		{
+			name:          "invalidChampionKey",
+			filters:       &filters.GetChampionDataFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("Aatrox")),
+			expectedError: errors.New("invalid key Aatrox for the champion Aatrox"),
+		},
+		{
+			name:          "statsDbError",
+			filters:       &filters.GetChampionDataFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockStats:     testutil.GetMockRepoError[[]*championrepo.ChampionRoleStats](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:              "noMatchesOnPatch",
+			filters:           &filters.GetChampionDataFilter{ChampionId: "Aatrox", Patch: "15.19"},
+			mockChampion:      testutil.NewSuccessResult(getMockChampion("266")),
+			mockStats:         testutil.NewSuccessResult([]*championrepo.ChampionRoleStats{}),
+			expectedPositions: []string{},
+		},
+		{
+			name:              "everythingFine",
+			filters:           &filters.GetChampionDataFilter{ChampionId: "Aatrox", Patch: "15.19"},
+			mockChampion:      testutil.NewSuccessResult(getMockChampion("266")),
+			mockStats:         testutil.NewSuccessResult(getMockChampionStats()),
+			expectedPositions: []string{"TOP", "JUNGLE"},
+		},
+		{
+			name:         "statsCached",
+			filters:      &filters.GetChampionDataFilter{ChampionId: "Aatrox", Patch: "15.19"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			cachedStats: []*dto.ChampionRoleStats{
+				{TeamPosition: "MIDDLE", Matches: 40, WinRate: 50},
+			},
+			expectedPositions: []string{"MIDDLE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockCache, _, mockChampionRepo, mockStatsCaches := setupTestService()
+
+			setupMocks(mockSetup{
+				cache:        mockCache,
+				repo:         mockChampionRepo,
+				statsCaches:  mockStatsCaches,
+				cachedStats:  tt.cachedStats,
+				championKey:  266,
+				mockChampion: tt.mockChampion,
+				mockStats:    tt.mockStats,
+			})
+
+			result, err := service.GetChampionData(context.Background(), tt.filters)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Aatrox", result.ID)
+			assert.Equal(t, tt.filters.Patch, result.Patch)
+			assert.Len(t, result.Stats, len(tt.expectedPositions))
+			for i, position := range tt.expectedPositions {
+				assert.Equal(t, position, result.Stats[i].TeamPosition)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockCache, mockChampionRepo, mockStatsCaches.roleStats)
+		})
+	}
+}
+
+func TestGetChampionBuilds(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters *filters.ChampionStatsFilter
+
+		mockChampion *testutil.OperationRestult[*champion.Champion]
+		mockItems    *testutil.OperationRestult[map[string]*item.Item]
+		mockEvents   *testutil.OperationRestult[[]*championrepo.ChampionItemEvent]
+		cachedBuilds *dto.ChampionBuilds
+
+		expectedMatches  int
+		expectedStarting []string
+		expectedCore     []string
+		expectedBoots    []string
+		expectedError    error
+	}{
+		{
+			name:          "championNotCached",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewErrorResult[*champion.Champion]("error getting from the database fallback"),
+			expectedError: errors.New("error getting from the database fallback"),
+		},
+		{
+			name:          "itemsNotCached",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockItems:     testutil.NewErrorResult[map[string]*item.Item]("failed to load items from fallback cache"),
+			expectedError: errors.New("couldn't get the items"),
+		},
+		{
+			name:          "eventsDbError",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockItems:     testutil.NewSuccessResult(getMockItems()),
+			mockEvents:    testutil.GetMockRepoError[[]*championrepo.ChampionItemEvent](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:         "noMatches",
+			filters:      &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			mockItems:    testutil.NewSuccessResult(getMockItems()),
+			mockEvents:   testutil.NewSuccessResult([]*championrepo.ChampionItemEvent{}),
+		},
+		{
+			name:             "everythingFine",
+			filters:          &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP", Patch: "15.19"},
+			mockChampion:     testutil.NewSuccessResult(getMockChampion("266")),
+			mockItems:        testutil.NewSuccessResult(getMockItems()),
+			mockEvents:       testutil.NewSuccessResult(getMockItemEvents()),
+			expectedMatches:  3,
+			expectedStarting: []string{"1055", "2003"},
+			expectedCore:     []string{"6692", "3071", "3053"},
+			expectedBoots:    []string{"3047"},
+		},
+		{
+			name:         "buildsCached",
+			filters:      &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			cachedBuilds: &dto.ChampionBuilds{
+				ChampionId:    "Aatrox",
+				Role:          "TOP",
+				Matches:       12,
+				StartingItems: &dto.BuildOptions{},
+				CoreItems:     &dto.BuildOptions{},
+				Boots:         &dto.BuildOptions{},
+			},
+			expectedMatches: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockCache, mockItemCache, mockChampionRepo, mockStatsCaches := setupTestService()
+
+			setupMocks(mockSetup{
+				cache:        mockCache,
+				itemCache:    mockItemCache,
+				repo:         mockChampionRepo,
+				statsCaches:  mockStatsCaches,
+				cachedStats:  tt.cachedBuilds,
+				championKey:  266,
+				mockChampion: tt.mockChampion,
+				mockItems:    tt.mockItems,
+				mockEvents:   tt.mockEvents,
+			})
+
+			result, err := service.GetChampionBuilds(context.Background(), tt.filters)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Aatrox", result.ChampionId)
+			assert.Equal(t, tt.filters.Role, result.Role)
+			assert.Equal(t, tt.expectedMatches, result.Matches)
+
+			// The samples are too small for the highest win rate lists.
+			assert.Empty(t, result.StartingItems.HighestWinRate)
+			assert.Empty(t, result.CoreItems.HighestWinRate)
+			assert.Empty(t, result.Boots.HighestWinRate)
+
+			assertFirstOption(t, tt.expectedStarting, result.StartingItems.MostCommon)
+			assertFirstOption(t, tt.expectedCore, result.CoreItems.MostCommon)
+			assertFirstOption(t, tt.expectedBoots, result.Boots.MostCommon)
+
+			servicetestutil.VerifyAllMocks(t, mockCache, mockItemCache, mockChampionRepo, mockStatsCaches.builds)
+		})
+	}
+}
+
+// assertFirstOption checks the items of the most common option, that is picked on two of the three matches.
+func assertFirstOption(t *testing.T, expected []string, options []*dto.BuildOption) {
+	t.Helper()
+
+	if expected == nil {
+		assert.Empty(t, options)
+		return
+	}
+
+	assert.NotEmpty(t, options)
+	assert.Equal(t, 2, options[0].Matches)
+	assert.Equal(t, 66.67, options[0].PickRate)
+
+	itemIds := make([]string, len(options[0].Items))
+	for i, buildItem := range options[0].Items {
+		itemIds[i] = buildItem.ID
+	}
+	assert.Equal(t, expected, itemIds)
+}
+
+func TestGetChampionSkills(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters *filters.ChampionStatsFilter
+
+		mockChampion *testutil.OperationRestult[*champion.Champion]
+		mockSkills   *testutil.OperationRestult[[]*championrepo.ChampionSkillEvent]
+		cachedSkills *dto.ChampionSkills
+
+		expectedMatches     int
+		expectedMaxOrder    []string
+		expectedFirstLevels []string
+		expectedError       error
+	}{
+		{
+			name:          "championNotCached",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewErrorResult[*champion.Champion]("error getting from the database fallback"),
+			expectedError: errors.New("error getting from the database fallback"),
+		},
+		{
+			name:          "skillsDbError",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockSkills:    testutil.GetMockRepoError[[]*championrepo.ChampionSkillEvent](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:         "noMatches",
+			filters:      &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			mockSkills:   testutil.NewSuccessResult([]*championrepo.ChampionSkillEvent{}),
+		},
+		{
+			name:                "everythingFine",
+			filters:             &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP", Patch: "15.19"},
+			mockChampion:        testutil.NewSuccessResult(getMockChampion("266")),
+			mockSkills:          testutil.NewSuccessResult(getMockSkillEvents()),
+			expectedMatches:     4,
+			expectedMaxOrder:    []string{"Q", "E", "W"},
+			expectedFirstLevels: []string{"Q", "E", "W"},
+		},
+		{
+			name:         "skillsCached",
+			filters:      &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			cachedSkills: &dto.ChampionSkills{
+				ChampionId:  "Aatrox",
+				Role:        "TOP",
+				Matches:     12,
+				MaxOrder:    &dto.SkillOptions{},
+				FirstLevels: &dto.SkillOptions{},
+			},
+			expectedMatches: 12,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockCache, _, mockChampionRepo, mockStatsCaches := setupTestService()
+
+			setupMocks(mockSetup{
+				cache:        mockCache,
+				repo:         mockChampionRepo,
+				statsCaches:  mockStatsCaches,
+				cachedStats:  tt.cachedSkills,
+				championKey:  266,
+				mockChampion: tt.mockChampion,
+				mockSkills:   tt.mockSkills,
+			})
+
+			result, err := service.GetChampionSkills(context.Background(), tt.filters)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Aatrox", result.ChampionId)
+			assert.Equal(t, tt.expectedMatches, result.Matches)
+			assert.Empty(t, result.MaxOrder.HighestWinRate)
+			assert.Empty(t, result.FirstLevels.HighestWinRate)
+
+			assertFirstSkillOrder(t, tt.expectedMaxOrder, result.MaxOrder.MostCommon)
+			assertFirstSkillOrder(t, tt.expectedFirstLevels, result.FirstLevels.MostCommon)
+
+			servicetestutil.VerifyAllMocks(t, mockCache, mockChampionRepo, mockStatsCaches.skills)
+		})
+	}
+}
+
+// assertFirstSkillOrder checks the skills of the most common order, that is picked on two of the four matches.
+func assertFirstSkillOrder(t *testing.T, expected []string, orders []*dto.SkillOrder) {
+	t.Helper()
+
+	if expected == nil {
+		assert.Empty(t, orders)
+		return
+	}
+
+	assert.NotEmpty(t, orders)
+	assert.Equal(t, 2, orders[0].Matches)
+	assert.Equal(t, 50.0, orders[0].PickRate)
+	assert.Equal(t, 50.0, orders[0].WinRate)
+
+	keys := make([]string, len(orders[0].Skills))
+	for i, skill := range orders[0].Skills {
+		keys[i] = skill.Key
+	}
+	assert.Equal(t, expected, keys)
+
+	// The spells are resolved by the slot.
+	assert.Equal(t, "The Darkin Blade", orders[0].Skills[0].Name)
+}
+
+func TestGetChampionMatchups(t *testing.T) {
+	darius := &champion.Champion{ID: "Darius", NameKey: "122", Name: "Darius"}
+
+	tests := []struct {
+		name    string
+		filters *filters.ChampionStatsFilter
+
+		mockChampion *testutil.OperationRestult[*champion.Champion]
+		mockMatchups *testutil.OperationRestult[[]*championrepo.ChampionMatchupStats]
+		mockAll      *testutil.OperationRestult[[]*champion.Champion]
+		cachedResult *dto.ChampionMatchups
+
+		expectedOpponents []string
+		expectedError     error
+	}{
+		{
+			name:          "championNotCached",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewErrorResult[*champion.Champion]("error getting from the database fallback"),
+			expectedError: errors.New("error getting from the database fallback"),
+		},
+		{
+			name:          "matchupsDbError",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockMatchups:  testutil.GetMockRepoError[[]*championrepo.ChampionMatchupStats](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:          "opponentsNotCached",
+			filters:       &filters.ChampionStatsFilter{ChampionId: "Aatrox"},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockMatchups:  testutil.NewSuccessResult(getMockMatchups()),
+			mockAll:       testutil.NewErrorResult[[]*champion.Champion]("failed to load champions from fallback cache"),
+			expectedError: errors.New("couldn't get the opponent champions"),
+		},
+		{
+			name:              "noMatches",
+			filters:           &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion:      testutil.NewSuccessResult(getMockChampion("266")),
+			mockMatchups:      testutil.NewSuccessResult([]*championrepo.ChampionMatchupStats{}),
+			mockAll:           testutil.NewSuccessResult([]*champion.Champion{darius}),
+			expectedOpponents: []string{},
+		},
+		{
+			name:              "everythingFine",
+			filters:           &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP", Patch: "15.19"},
+			mockChampion:      testutil.NewSuccessResult(getMockChampion("266")),
+			mockMatchups:      testutil.NewSuccessResult(getMockMatchups()),
+			mockAll:           testutil.NewSuccessResult([]*champion.Champion{darius}),
+			expectedOpponents: []string{"Darius", "999"},
+		},
+		{
+			name:         "matchupsCached",
+			filters:      &filters.ChampionStatsFilter{ChampionId: "Aatrox", Role: "TOP"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			cachedResult: &dto.ChampionMatchups{
+				ChampionId: "Aatrox",
+				Role:       "TOP",
+				Matchups: []*dto.ChampionMatchup{
+					{OpponentId: "Darius", TeamPosition: "TOP", Matches: 12},
+				},
+			},
+			expectedOpponents: []string{"Darius"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockCache, _, mockChampionRepo, mockStatsCaches := setupTestService()
+
+			setupMocks(mockSetup{
+				cache:        mockCache,
+				repo:         mockChampionRepo,
+				statsCaches:  mockStatsCaches,
+				cachedStats:  tt.cachedResult,
+				championKey:  266,
+				mockChampion: tt.mockChampion,
+				mockMatchups: tt.mockMatchups,
+				mockAll:      tt.mockAll,
+			})
+
+			result, err := service.GetChampionMatchups(context.Background(), tt.filters)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Aatrox", result.ChampionId)
+			assert.Equal(t, tt.filters.Patch, result.Patch)
+			assert.Len(t, result.Matchups, len(tt.expectedOpponents))
+			for i, opponent := range tt.expectedOpponents {
+				assert.Equal(t, opponent, result.Matchups[i].OpponentId)
+			}
+
+			if len(result.Matchups) == 2 {
+				assert.Equal(t, "Darius", result.Matchups[0].OpponentName)
+				assert.Equal(t, 152.5, *result.Matchups[0].GoldDiffAt10)
+				assert.Nil(t, result.Matchups[1].GoldDiffAt15)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockCache, mockChampionRepo, mockStatsCaches.matchups)
+		})
+	}
+}
+
+func TestGetChampionSynergies(t *testing.T) {
+	allies := []*champion.Champion{
+		{ID: "Braum", NameKey: "201", Name: "Braum"},
+		{ID: "Thresh", NameKey: "412", Name: "Thresh"},
+	}
+
+	tests := []struct {
+		name    string
+		filters *filters.TierlistFilter
+
+		mockChampion  *testutil.OperationRestult[*champion.Champion]
+		mockSynergies *testutil.OperationRestult[[]*championrepo.ChampionSynergyStats]
+		mockAll       *testutil.OperationRestult[[]*champion.Champion]
+		cachedResult  *dto.ChampionSynergies
+
+		expectedAllies   []string
+		expectedSynergy  []float64
+		expectedExpected []float64
+		expectedError    error
+	}{
+		{
+			name:          "championNotCached",
+			filters:       &filters.TierlistFilter{},
+			mockChampion:  testutil.NewErrorResult[*champion.Champion]("error getting from the database fallback"),
+			expectedError: errors.New("error getting from the database fallback"),
+		},
+		{
+			name:          "synergiesDbError",
+			filters:       &filters.TierlistFilter{},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockSynergies: testutil.GetMockRepoError[[]*championrepo.ChampionSynergyStats](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:          "alliesNotCached",
+			filters:       &filters.TierlistFilter{},
+			mockChampion:  testutil.NewSuccessResult(getMockChampion("266")),
+			mockSynergies: testutil.NewSuccessResult(getMockSynergies()),
+			mockAll:       testutil.NewErrorResult[[]*champion.Champion]("failed to load champions from fallback cache"),
+			expectedError: errors.New("couldn't get the ally champions"),
+		},
+		{
+			name:           "noMatches",
+			filters:        &filters.TierlistFilter{Role: "BOTTOM"},
+			mockChampion:   testutil.NewSuccessResult(getMockChampion("266")),
+			mockSynergies:  testutil.NewSuccessResult([]*championrepo.ChampionSynergyStats{}),
+			mockAll:        testutil.NewSuccessResult(allies),
+			expectedAllies: []string{},
+		},
+		{
+			name:             "sortedBySynergy",
+			filters:          &filters.TierlistFilter{Role: "BOTTOM", Patch: "15.19"},
+			mockChampion:     testutil.NewSuccessResult(getMockChampion("266")),
+			mockSynergies:    testutil.NewSuccessResult(getMockSynergies()),
+			mockAll:          testutil.NewSuccessResult(allies),
+			expectedAllies:   []string{"Thresh", "999", "Braum"},
+			expectedSynergy:  []float64{6, -1, -2},
+			expectedExpected: []float64{50, 51, 54},
+		},
+		{
+			name:         "synergiesCached",
+			filters:      &filters.TierlistFilter{Role: "BOTTOM"},
+			mockChampion: testutil.NewSuccessResult(getMockChampion("266")),
+			cachedResult: &dto.ChampionSynergies{
+				ChampionId: "Aatrox",
+				Role:       "BOTTOM",
+				Synergies: []*dto.ChampionSynergy{
+					{AllyId: "Thresh", Synergy: 6, ExpectedWinRate: 50},
+				},
+			},
+			expectedAllies:   []string{"Thresh"},
+			expectedSynergy:  []float64{6},
+			expectedExpected: []float64{50},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockCache, _, mockChampionRepo, mockStatsCaches := setupTestService()
+
+			setupMocks(mockSetup{
+				cache:         mockCache,
+				repo:          mockChampionRepo,
+				statsCaches:   mockStatsCaches,
+				cachedStats:   tt.cachedResult,
+				championKey:   266,
+				mockChampion:  tt.mockChampion,
+				mockSynergies: tt.mockSynergies,
+				mockAll:       tt.mockAll,
+			})
+
+			result, err := service.GetChampionSynergies(context.Background(), "Aatrox", tt.filters)
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "Aatrox", result.ChampionId)
+			assert.Equal(t, tt.filters.Role, result.Role)
+			assert.Len(t, result.Synergies, len(tt.expectedAllies))
+			for i, ally := range tt.expectedAllies {
+				assert.Equal(t, ally, result.Synergies[i].AllyId)
+				assert.Equal(t, tt.expectedSynergy[i], result.Synergies[i].Synergy)
+				assert.Equal(t, tt.expectedExpected[i], result.Synergies[i].ExpectedWinRate)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockCache, mockChampionRepo, mockStatsCaches.synergies)
+		})
+	}
+}
+
+func TestGetChampionStatsKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		filters     *filters.ChampionStatsFilter
+		expectedKey string
+	}{
+		{
+			name:        "defaultFilters",
+			filters:     &filters.ChampionStatsFilter{Queue: 420},
+			expectedKey: "champion_role_stats:champion_266:queue_420",
+		},
+		{
+			name:        "withPatchAndRole",
+			filters:     &filters.ChampionStatsFilter{Queue: 420, Patch: "15.19", Role: "TOP"},
+			expectedKey: "champion_role_stats:champion_266:queue_420:patch_15.19:role_TOP",
+		},
+		{
+			name:        "withTierAndDays",
+			filters:     &filters.ChampionStatsFilter{Queue: 440, MinRating: 2000, MaxRating: 2399, Days: 30},
+			expectedKey: "champion_role_stats:champion_266:queue_440:min_rating_2000:max_rating_2399:days_30",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKey, getChampionStatsKey(championRoleStatsCacheName, 266, tt.filters))
+		})
+	}
+}
+
+func TestGetChampionSynergiesKey(t *testing.T) {
+	since := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 10, 16, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		filters     *filters.TierlistFilter
+		expectedKey string
+	}{
+		{
+			name:        "noFilters",
+			filters:     &filters.TierlistFilter{},
+			expectedKey: "champion_synergies:champion_266",
+		},
+		{
+			name:        "withTierlistFilters",
+			filters:     &filters.TierlistFilter{Queue: 420, NumericTier: 30000, GetTiersAbove: true, Patch: "15.19", Region: "BR1", Role: "BOTTOM", MinGames: 20},
+			expectedKey: "champion_synergies:champion_266:queue_420:tier_30000:with_higher_tiers:patch_15.19:region_BR1:role_BOTTOM:min_games_20",
+		},
+		{
+			name:        "withDays",
+			filters:     &filters.TierlistFilter{Days: 7, Since: since},
+			expectedKey: "champion_synergies:champion_266:days_7",
+		},
+		{
+			name:        "withCustomPeriod",
+			filters:     &filters.TierlistFilter{Since: since, Until: until, CustomPeriod: true},
+			expectedKey: "champion_synergies:champion_266:since_2025-10-01:until_2025-10-16",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKey, getChampionSynergiesKey(266, tt.filters))
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package champion
+
+import (
+	"goleague/api/converters"
+	"goleague/api/dto"
+	championrepo "goleague/api/repositories/champion"
+	"goleague/pkg/models/champion"
+	"slices"
+	"sort"
+)
+
+const (
+	// Points to max a basic skill, the ultimate is never part of the max order.
+	basicSkillMaxPoints = 5
+	firstLevelsCount    = 3
+)
+
+// Keys of the skill slots, in the same order of the champion spells.
+var skillKeys = []string{"Q", "W", "E", "R"}
+
+// Basic skill slots, ranked on the max order.
+var basicSkillSlots = []int{1, 2, 3}
+
+// playerSkills is the order of the skill points of a player.
+type playerSkills struct {
+	win   bool
+	slots []int
+}
+
+// aggregateSkills returns the max orders and the first levels of the players.
+func aggregateSkills(result *dto.ChampionSkills, events []*championrepo.ChampionSkillEvent, spells []champion.Spell) {
+	players := groupPlayerSkills(events)
+
+	maxOrders := make(buildCounter)
+	firstLevels := make(buildCounter)
+
+	for _, player := range players {
+		if len(player.slots) >= firstLevelsCount {
+			firstLevels.add(slotKeys(player.slots[:firstLevelsCount]), player.win)
+		}
+
+		if order := maxOrder(player.slots); order != nil {
+			maxOrders.add(slotKeys(order), player.win)
+		}
+	}
+
+	result.Matches = len(players)
+	result.MaxOrder = skillOptions(maxOrders, len(players), spells)
+	result.FirstLevels = skillOptions(firstLevels, len(players), spells)
+}
+
+// groupPlayerSkills groups the ordered events by player.
+func groupPlayerSkills(events []*championrepo.ChampionSkillEvent) []*playerSkills {
+	var players []*playerSkills
+	var current *playerSkills
+	var currentId uint64
+
+	for _, event := range events {
+		if current == nil || event.MatchStatId != currentId {
+			current = &playerSkills{win: event.Win}
+			currentId = event.MatchStatId
+			players = append(players, current)
+		}
+
+		if event.SkillSlot >= 1 && event.SkillSlot <= len(skillKeys) {
+			current.slots = append(current.slots, event.SkillSlot)
+		}
+	}
+
+	return players
+}
+
+// maxOrder returns the basic skills by the order they were maxed.
+// The skills that weren't maxed before the match ended follow by the points, then by the first point.
+// Returns nil if no skill was maxed, the match is too short to tell the order.
+func maxOrder(slots []int) []int {
+	points := make(map[int]int)
+	maxedAt := make(map[int]int)
+	firstAt := make(map[int]int)
+
+	for i, slot := range slots {
+		if _, exists := firstAt[slot]; !exists {
+			firstAt[slot] = i
+		}
+
+		points[slot]++
+		if points[slot] == basicSkillMaxPoints {
+			maxedAt[slot] = i
+		}
+	}
+
+	order := make([]int, 0, len(basicSkillSlots))
+	maxed := false
+	for _, slot := range basicSkillSlots {
+		order = append(order, slot)
+		if _, exists := maxedAt[slot]; exists {
+			maxed = true
+		}
+	}
+
+	if !maxed {
+		return nil
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		left, leftMaxed := maxedAt[order[i]]
+		right, rightMaxed := maxedAt[order[j]]
+		switch {
+		case leftMaxed && rightMaxed:
+			return left < right
+		case leftMaxed != rightMaxed:
+			return leftMaxed
+		case points[order[i]] != points[order[j]]:
+			return points[order[i]] > points[order[j]]
+		}
+		return firstAt[order[i]] < firstAt[order[j]]
+	})
+
+	return order
+}
+
+// slotKeys converts the skill slots to the keys, like Q.
+func slotKeys(slots []int) []string {
+	keys := make([]string, len(slots))
+	for i, slot := range slots {
+		keys[i] = skillKeys[slot-1]
+	}
+	return keys
+}
+
+// skillOptions returns the most common and the highest win rate skill orders, with the champion spells.
+func skillOptions(counter buildCounter, totalMatches int, spells []champion.Spell) *dto.SkillOptions {
+	mostCommon, highestWinRate := counter.rank(totalMatches)
+
+	return &dto.SkillOptions{
+		MostCommon:     toSkillOrders(mostCommon, totalMatches, spells),
+		HighestWinRate: toSkillOrders(highestWinRate, totalMatches, spells),
+	}
+}
+
+// toSkillOrders converts the counts to the skill orders with the spell data.
+func toSkillOrders(counts []*buildCount, totalMatches int, spells []champion.Spell) []*dto.SkillOrder {
+	orders := make([]*dto.SkillOrder, 0, len(counts))
+	for _, count := range counts {
+		order := &dto.SkillOrder{
+			Skills:   make([]*dto.Skill, len(count.ids)),
+			Matches:  count.matches,
+			PickRate: converters.Percentage(count.matches, totalMatches),
+			WinRate:  converters.Percentage(count.wins, count.matches),
+		}
+
+		for i, key := range count.ids {
+			slot := slices.Index(skillKeys, key) + 1
+			skill := &dto.Skill{Key: key, Slot: slot}
+
+			// The cached spells are in the slot order.
+			if slot <= len(spells) {
+				skill.Name = spells[slot-1].Name
+				skill.Image = spells[slot-1].Image
+			}
+
+			order.Skills[i] = skill
+		}
+
+		orders = append(orders, order)
+	}
+
+	return orders
+}
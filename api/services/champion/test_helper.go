@@ -0,0 +1,277 @@
+package champion
+
+import (
+	"goleague/api/dto"
+	championrepo "goleague/api/repositories/champion"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"goleague/pkg/models/champion"
+	"goleague/pkg/models/item"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// Mock setup struct
+type mockSetup struct {
+	cache     *servicetestutil.MockChampionCache
+	itemCache *servicetestutil.MockItemCache
+	repo      *servicetestutil.MockChampionRepository
+
+	// Without cached stats, every statistic misses the cache and goes to the repository.
+	statsCaches *mockStatsCaches
+	cachedStats any
+
+	championKey   int
+	mockChampion  *testutil.OperationRestult[*champion.Champion]
+	mockStats     *testutil.OperationRestult[[]*championrepo.ChampionRoleStats]
+	mockItems     *testutil.OperationRestult[map[string]*item.Item]
+	mockEvents    *testutil.OperationRestult[[]*championrepo.ChampionItemEvent]
+	mockSkills    *testutil.OperationRestult[[]*championrepo.ChampionSkillEvent]
+	mockMatchups  *testutil.OperationRestult[[]*championrepo.ChampionMatchupStats]
+	mockAll       *testutil.OperationRestult[[]*champion.Champion]
+	mockSynergies *testutil.OperationRestult[[]*championrepo.ChampionSynergyStats]
+}
+
+// Memory caches of the champion statistics.
+type mockStatsCaches struct {
+	roleStats *servicetestutil.MockMemCache[[]*dto.ChampionRoleStats]
+	builds    *servicetestutil.MockMemCache[*dto.ChampionBuilds]
+	skills    *servicetestutil.MockMemCache[*dto.ChampionSkills]
+	matchups  *servicetestutil.MockMemCache[*dto.ChampionMatchups]
+	synergies *servicetestutil.MockMemCache[*dto.ChampionSynergies]
+}
+
+// Helper to initialize the mocks.
+func setupTestService() (*ChampionService, *servicetestutil.MockChampionCache, *servicetestutil.MockItemCache, *servicetestutil.MockChampionRepository, *mockStatsCaches) {
+	mockCache := new(servicetestutil.MockChampionCache)
+	mockItemCache := new(servicetestutil.MockItemCache)
+	mockChampionRepo := new(servicetestutil.MockChampionRepository)
+	mockStatsCaches := &mockStatsCaches{
+		roleStats: new(servicetestutil.MockMemCache[[]*dto.ChampionRoleStats]),
+		builds:    new(servicetestutil.MockMemCache[*dto.ChampionBuilds]),
+		skills:    new(servicetestutil.MockMemCache[*dto.ChampionSkills]),
+		matchups:  new(servicetestutil.MockMemCache[*dto.ChampionMatchups]),
+		synergies: new(servicetestutil.MockMemCache[*dto.ChampionSynergies]),
+	}
+
+	// Redis never has the stats, the memory cache is enough to test the cache hits.
+	mockRedis := new(servicetestutil.MockChampionRedisClient)
+	mockRedis.On("Get", mock.Anything, mock.Anything).Return("", nil).Maybe()
+	mockRedis.On("Set", mock.Anything, mock.Anything, mock.Anything, ChampionStatsRedisCacheDuration).Return(nil).Maybe()
+
+	service := &ChampionService{
+		db:                 new(gorm.DB),
+		championCache:      mockCache,
+		itemCache:          mockItemCache,
+		roleStatsMemCache:  mockStatsCaches.roleStats,
+		buildsMemCache:     mockStatsCaches.builds,
+		skillsMemCache:     mockStatsCaches.skills,
+		matchupsMemCache:   mockStatsCaches.matchups,
+		synergiesMemCache:  mockStatsCaches.synergies,
+		redis:              mockRedis,
+		ChampionRepository: mockChampionRepo,
+	}
+
+	return service, mockCache, mockItemCache, mockChampionRepo, mockStatsCaches
+}
+
+// setupStatsCache returns the cached stats on the memory cache of its type, the other caches always miss.
+func setupStatsCache[T any](memCache *servicetestutil.MockMemCache[T], cachedStats any) {
+	cached, _ := cachedStats.(T)
+	memCache.On("Get", mock.Anything).Return(cached).Maybe()
+	memCache.On("Set", mock.Anything, mock.Anything, ChampionStatsMemoryCacheDuration).Return().Maybe()
+}
+
+func setupMocks(setup mockSetup) {
+	if setup.statsCaches != nil {
+		setupStatsCache(setup.statsCaches.roleStats, setup.cachedStats)
+		setupStatsCache(setup.statsCaches.builds, setup.cachedStats)
+		setupStatsCache(setup.statsCaches.skills, setup.cachedStats)
+		setupStatsCache(setup.statsCaches.matchups, setup.cachedStats)
+		setupStatsCache(setup.statsCaches.synergies, setup.cachedStats)
+	}
+
+	if setup.mockChampion != nil {
+		setup.cache.On("GetChampionCopy", mock.Anything, mock.Anything).Return(setup.mockChampion.Data, setup.mockChampion.Err)
+	}
+
+	if setup.mockStats != nil {
+		setup.repo.On("GetChampionStats", mock.Anything, setup.championKey, mock.Anything).Return(setup.mockStats.Data, setup.mockStats.Err)
+	}
+
+	if setup.mockItems != nil {
+		setup.itemCache.On("GetAllItems", mock.Anything).Return(setup.mockItems.Data, setup.mockItems.Err)
+	}
+
+	if setup.mockEvents != nil {
+		setup.repo.On("GetChampionItemEvents", mock.Anything, setup.championKey, mock.Anything).Return(setup.mockEvents.Data, setup.mockEvents.Err)
+	}
+
+	if setup.mockMatchups != nil {
+		setup.repo.On("GetChampionMatchups", mock.Anything, setup.championKey, mock.Anything).Return(setup.mockMatchups.Data, setup.mockMatchups.Err)
+	}
+
+	if setup.mockSynergies != nil {
+		setup.repo.On("GetChampionSynergies", mock.Anything, setup.championKey, mock.Anything).Return(setup.mockSynergies.Data, setup.mockSynergies.Err)
+	}
+
+	if setup.mockAll != nil {
+		setup.cache.On("GetAllChampions", mock.Anything).Return(setup.mockAll.Data, setup.mockAll.Err)
+	}
+
+	if setup.mockSkills != nil {
+		setup.repo.On("GetChampionSkillEvents", mock.Anything, setup.championKey, mock.Anything).Return(setup.mockSkills.Data, setup.mockSkills.Err)
+	}
+}
+
+// Return a mocked champion from the cache.
+func getMockChampion(key string) *champion.Champion {
+	return &champion.Champion{
+		ID:      "Aatrox",
+		NameKey: key,
+		Name:    "Aatrox",
+		Title:   "the Darkin Blade",
+		Spells: []champion.Spell{
+			{ID: "AatroxQ", Name: "The Darkin Blade"},
+			{ID: "AatroxW", Name: "Infernal Chains"},
+			{ID: "AatroxE", Name: "Umbral Dash"},
+			{ID: "AatroxR", Name: "World Ender"},
+		},
+	}
+}
+
+// Return mocked stats, already sorted by the matches.
+func getMockChampionStats() []*championrepo.ChampionRoleStats {
+	return []*championrepo.ChampionRoleStats{
+		{TeamPosition: "TOP", Matches: 120, WinRate: 51.2},
+		{TeamPosition: "JUNGLE", Matches: 8, WinRate: 37.5},
+	}
+}
+
+// Return a mocked item list, with the build paths of the items.
+func getMockItems() map[string]*item.Item {
+	items := []*item.Item{
+		{ID: "1001", Name: "Boots", Gold: item.Gold{Total: 300, Purchasable: true}, Into: []string{"3047", "3111"}, Tags: []string{"Boots"}},
+		{ID: "1036", Name: "Long Sword", Gold: item.Gold{Total: 350, Purchasable: true}, Into: []string{"6692", "3071"}},
+		{ID: "1055", Name: "Doran's Blade", Gold: item.Gold{Total: 450, Purchasable: true}},
+		{ID: "2003", Name: "Health Potion", Gold: item.Gold{Total: 50, Purchasable: true}, Tags: []string{"Consumable"}},
+		{ID: "3047", Name: "Plated Steelcaps", Gold: item.Gold{Total: 1200, Purchasable: true}, From: []string{"1001"}, Tags: []string{"Boots"}},
+		{ID: "3053", Name: "Sterak's Gage", Gold: item.Gold{Total: 3200, Purchasable: true}},
+		{ID: "3071", Name: "Black Cleaver", Gold: item.Gold{Total: 3000, Purchasable: true}, From: []string{"1036"}},
+		{ID: "3111", Name: "Mercury's Treads", Gold: item.Gold{Total: 1250, Purchasable: true}, From: []string{"1001"}, Tags: []string{"Boots"}},
+		{ID: "3340", Name: "Stealth Ward", Gold: item.Gold{Total: 0, Purchasable: true}},
+		{ID: "6333", Name: "Death's Dance", Gold: item.Gold{Total: 3300, Purchasable: true}},
+		{ID: "6692", Name: "Eclipse", Gold: item.Gold{Total: 2900, Purchasable: true}, From: []string{"1036"}},
+	}
+
+	result := make(map[string]*item.Item, len(items))
+	for _, itemData := range items {
+		result[itemData.ID] = itemData
+	}
+	return result
+}
+
+// Return mocked item events of three players, already ordered by player and time.
+func getMockItemEvents() []*championrepo.ChampionItemEvent {
+	purchases := []struct {
+		matchStatId uint64
+		win         bool
+		itemIds     []int
+	}{
+		{1, true, []int{1055, 2003, 3340, 1001, 1036, 6692, 3047, 3071, 6333, 3053}},
+		{2, false, []int{1055, 2003, 3340, 6692, 3111, 3071, 3053}},
+		{3, true, []int{1055, 2003, 2003, 6692, 6333, 3047, 3071}},
+	}
+
+	var events []*championrepo.ChampionItemEvent
+	for _, player := range purchases {
+		for i, itemId := range player.itemIds {
+			// The first three purchases are made on the fountain.
+			timestamp := int64(i) * 1000
+			if i >= 3 {
+				timestamp = int64(i) * 120_000
+			}
+
+			events = append(events, &championrepo.ChampionItemEvent{
+				MatchStatId: player.matchStatId,
+				Win:         player.win,
+				ItemId:      itemId,
+				Action:      "ITEM_PURCHASED",
+				Timestamp:   timestamp,
+			})
+
+			// The first player undoes the Death's Dance.
+			if player.matchStatId == 1 && itemId == 6333 {
+				events = append(events, &championrepo.ChampionItemEvent{
+					MatchStatId: player.matchStatId,
+					Win:         player.win,
+					ItemId:      itemId,
+					Action:      "ITEM_UNDO",
+					Timestamp:   timestamp + 1000,
+				})
+			}
+		}
+	}
+
+	return events
+}
+
+// Return mocked skill events of four players, already ordered by player and time.
+func getMockSkillEvents() []*championrepo.ChampionSkillEvent {
+	skillPoints := []struct {
+		matchStatId uint64
+		win         bool
+		slots       []int
+	}{
+		{1, true, []int{1, 3, 2, 1, 1, 4, 1, 3, 1}},
+		{2, false, []int{1, 3, 2, 1, 1, 4, 1, 3, 1, 3, 3}},
+		{3, true, []int{3, 1, 2, 3, 3, 4, 3, 1, 3}},
+		// Remake, too short for a max order.
+		{4, false, []int{1, 2, 3}},
+	}
+
+	var events []*championrepo.ChampionSkillEvent
+	for _, player := range skillPoints {
+		for i, slot := range player.slots {
+			events = append(events, &championrepo.ChampionSkillEvent{
+				MatchStatId: player.matchStatId,
+				Win:         player.win,
+				SkillSlot:   slot,
+				Timestamp:   int64(i) * 60_000,
+			})
+		}
+	}
+
+	return events
+}
+
+// Return mocked matchups, the last opponent isn't on the champion cache and has no frames.
+func getMockMatchups() []*championrepo.ChampionMatchupStats {
+	goldDiff, xpDiff, csDiff := 152.5, 80.25, 4.5
+
+	return []*championrepo.ChampionMatchupStats{
+		{
+			TeamPosition: "TOP",
+			OpponentKey:  122,
+			Matches:      40,
+			WinRate:      55,
+			GoldDiffAt10: &goldDiff,
+			XpDiffAt10:   &xpDiff,
+			CsDiffAt10:   &csDiff,
+			GoldDiffAt15: &goldDiff,
+			XpDiffAt15:   &xpDiff,
+			CsDiffAt15:   &csDiff,
+		},
+		{TeamPosition: "TOP", OpponentKey: 999, Matches: 2, WinRate: 50},
+	}
+}
+
+// Return mocked synergies, ordered by the matches like the repository.
+func getMockSynergies() []*championrepo.ChampionSynergyStats {
+	return []*championrepo.ChampionSynergyStats{
+		{TeamPosition: "BOTTOM", AllyKey: 201, AllyPosition: "UTILITY", Matches: 80, WinRate: 52, ChampionWinRate: 51, AllyWinRate: 53},
+		{TeamPosition: "BOTTOM", AllyKey: 412, AllyPosition: "UTILITY", Matches: 50, WinRate: 56, ChampionWinRate: 51, AllyWinRate: 49},
+		{TeamPosition: "BOTTOM", AllyKey: 999, AllyPosition: "UTILITY", Matches: 12, WinRate: 50, ChampionWinRate: 51, AllyWinRate: 50},
+	}
+}
@@ -0,0 +1,107 @@
+package leaderboardservice
+
+import (
+	"context"
+	"fmt"
+	"goleague/api/converters"
+	"goleague/api/dto"
+	"goleague/api/filters"
+	leaderboardrepo "goleague/api/repositories/leaderboard"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// Window of the matches used for the most played champions.
+	mostPlayedDays = 30
+	// Champions returned for each player.
+	mostPlayedLimit = 3
+)
+
+// LeaderboardService exposes the ladder of each region.
+type LeaderboardService struct {
+	db                    *gorm.DB
+	LeaderboardRepository leaderboardrepo.LeaderboardRepository
+}
+
+// LeaderboardServiceDeps is the dependency list for the leaderboard service.
+type LeaderboardServiceDeps struct {
+	DB *gorm.DB
+}
+
+// NewLeaderboardService creates a leaderboard service.
+func NewLeaderboardService(deps *LeaderboardServiceDeps) *LeaderboardService {
+	return &LeaderboardService{
+		db:                    deps.DB,
+		LeaderboardRepository: leaderboardrepo.NewLeaderboardRepository(deps.DB),
+	}
+}
+
+// GetLeaderboard returns a page of the ladder with the most played champions of each player.
+func (ls *LeaderboardService) GetLeaderboard(ctx context.Context, filters *filters.LeaderboardFilter) (*dto.Leaderboard, error) {
+	entries, err := ls.LeaderboardRepository.GetLeaderboard(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.Leaderboard{
+		Region:  filters.Region,
+		Queue:   filters.Queue,
+		Tier:    filters.Tier,
+		Page:    filters.Page,
+		Entries: make([]*dto.LeaderboardEntry, len(entries)),
+	}
+
+	if len(entries) == 0 {
+		return result, nil
+	}
+
+	playerIds := make([]uint, len(entries))
+	for i, entry := range entries {
+		playerIds[i] = entry.PlayerId
+	}
+
+	since := time.Now().UTC().AddDate(0, 0, -mostPlayedDays)
+	counts, err := ls.LeaderboardRepository.GetMostPlayedChampions(ctx, playerIds, filters.QueueId, since, mostPlayedLimit)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the most played champions: %w", err)
+	}
+
+	champions := make(map[uint][]*dto.LeaderboardChampion, len(entries))
+	for _, count := range counts {
+		champions[count.PlayerId] = append(champions[count.PlayerId], &dto.LeaderboardChampion{
+			ChampionId: count.ChampionId,
+			Matches:    count.Matches,
+		})
+	}
+
+	for i, entry := range entries {
+		result.Entries[i] = &dto.LeaderboardEntry{
+			Position:     entry.Position,
+			Id:           entry.PlayerId,
+			Name:         entry.RiotIdGameName,
+			Tag:          entry.RiotIdTagline,
+			ProfileIcon:  entry.ProfileIcon,
+			Tier:         entry.Tier,
+			Rank:         entry.Rank,
+			LeaguePoints: entry.LeaguePoints,
+			Wins:         entry.Wins,
+			Losses:       entry.Losses,
+			WinRate:      converters.Percentage(entry.Wins, entry.Wins+entry.Losses),
+			Champions:    champions[entry.PlayerId],
+		}
+
+		// Positive changes are climbs on the ladder.
+		if entry.PreviousPosition != nil {
+			change := *entry.PreviousPosition - entry.Position
+			result.Entries[i].PositionChange = &change
+		}
+
+		if result.Entries[i].Champions == nil {
+			result.Entries[i].Champions = []*dto.LeaderboardChampion{}
+		}
+	}
+
+	return result, nil
+}
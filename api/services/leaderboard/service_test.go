@@ -0,0 +1,101 @@
+package leaderboardservice
+
+import (
+	"context"
+	"errors"
+	leaderboardrepo "goleague/api/repositories/leaderboard"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Simple test for asserting that everything is fine with the leaderboard service creation.
+func TestNewLeaderboardService(t *testing.T) {
+	deps := &LeaderboardServiceDeps{
+		DB: new(gorm.DB),
+	}
+
+	service := NewLeaderboardService(deps)
+	assert.NotNil(t, service)
+	assert.Equal(t, new(gorm.DB), service.db)
+	assert.NotNil(t, service.LeaderboardRepository)
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	tests := []struct {
+		name string
+
+		mockEntries   *testutil.OperationRestult[[]*leaderboardrepo.LeaderboardEntry]
+		mockChampions *testutil.OperationRestult[[]*leaderboardrepo.PlayerChampionCount]
+
+		expectedEntries int
+		expectedError   error
+	}{
+		{
+			name:          "dbError",
+			mockEntries:   testutil.GetMockRepoError[[]*leaderboardrepo.LeaderboardEntry](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:            "emptyPage",
+			mockEntries:     testutil.NewSuccessResult([]*leaderboardrepo.LeaderboardEntry{}),
+			expectedEntries: 0,
+		},
+		{
+			name:          "championsError",
+			mockEntries:   testutil.NewSuccessResult(getMockEntries()),
+			mockChampions: testutil.GetMockRepoError[[]*leaderboardrepo.PlayerChampionCount](),
+			expectedError: errors.New("couldn't get the most played champions"),
+		},
+		{
+			name:            "everythingFine",
+			mockEntries:     testutil.NewSuccessResult(getMockEntries()),
+			mockChampions:   testutil.NewSuccessResult(getMockChampions()),
+			expectedEntries: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockLeaderboardRepo := setupTestService()
+
+			setupMocks(mockSetup{
+				repo:          mockLeaderboardRepo,
+				mockEntries:   tt.mockEntries,
+				mockChampions: tt.mockChampions,
+			})
+
+			result, err := service.GetLeaderboard(context.Background(), getMockFilter())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "BR1", result.Region)
+			assert.Equal(t, "RANKED_SOLO_5x5", result.Queue)
+			assert.Equal(t, 1, result.Page)
+			assert.Len(t, result.Entries, tt.expectedEntries)
+
+			if tt.expectedEntries > 0 {
+				first, second := result.Entries[0], result.Entries[1]
+				assert.Equal(t, 75.0, first.WinRate)
+				assert.Equal(t, 2, *first.PositionChange)
+				assert.Len(t, first.Champions, 2)
+				assert.Equal(t, 103, first.Champions[0].ChampionId)
+
+				assert.Equal(t, 0.0, second.WinRate)
+				assert.Nil(t, second.PositionChange)
+				assert.Empty(t, second.Champions)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockLeaderboardRepo)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package leaderboardservice
+
+import (
+	"goleague/api/filters"
+	leaderboardrepo "goleague/api/repositories/leaderboard"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// Mock setup struct
+type mockSetup struct {
+	repo *servicetestutil.MockLeaderboardRepository
+
+	mockEntries   *testutil.OperationRestult[[]*leaderboardrepo.LeaderboardEntry]
+	mockChampions *testutil.OperationRestult[[]*leaderboardrepo.PlayerChampionCount]
+}
+
+// Helper to initialize the mocks.
+func setupTestService() (*LeaderboardService, *servicetestutil.MockLeaderboardRepository) {
+	mockLeaderboardRepo := new(servicetestutil.MockLeaderboardRepository)
+
+	service := &LeaderboardService{
+		db:                    new(gorm.DB),
+		LeaderboardRepository: mockLeaderboardRepo,
+	}
+
+	return service, mockLeaderboardRepo
+}
+
+func setupMocks(setup mockSetup) {
+	if setup.mockEntries != nil {
+		setup.repo.On("GetLeaderboard", mock.Anything, mock.Anything).Return(setup.mockEntries.Data, setup.mockEntries.Err)
+	}
+
+	if setup.mockChampions != nil {
+		setup.repo.On("GetMostPlayedChampions", mock.Anything, []uint{1, 2}, 420, mock.Anything, mostPlayedLimit).Return(setup.mockChampions.Data, setup.mockChampions.Err)
+	}
+}
+
+// Return the filter of the first page of the solo queue.
+func getMockFilter() *filters.LeaderboardFilter {
+	return filters.NewLeaderboardFilter(&filters.LeaderboardURIParams{Region: "br1"}, filters.LeaderboardQueryParams{})
+}
+
+// Return mocked entries, the second player is new on the ladder.
+func getMockEntries() []*leaderboardrepo.LeaderboardEntry {
+	previous := 3
+	return []*leaderboardrepo.LeaderboardEntry{
+		{PlayerId: 1, RiotIdGameName: "First", RiotIdTagline: "BR1", Region: "BR1", Tier: "CHALLENGER", Rank: "I", LeaguePoints: 1500, Wins: 150, Losses: 50, Position: 1, PreviousPosition: &previous},
+		{PlayerId: 2, RiotIdGameName: "Second", RiotIdTagline: "BR1", Region: "BR1", Tier: "CHALLENGER", Rank: "I", LeaguePoints: 1400, Wins: 0, Losses: 0, Position: 2},
+	}
+}
+
+// Return mocked champions, only for the first player.
+func getMockChampions() []*leaderboardrepo.PlayerChampionCount {
+	return []*leaderboardrepo.PlayerChampionCount{
+		{PlayerId: 1, ChampionId: 103, Matches: 40},
+		{PlayerId: 1, ChampionId: 84, Matches: 20},
+	}
+}
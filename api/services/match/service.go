@@ -2,30 +2,52 @@ package matchservice
 
 import (
 	"context"
+	"fmt"
 	"goleague/api/converters"
 	"goleague/api/dto"
 	"goleague/api/filters"
+	grpcclient "goleague/api/grpc"
 	matchrepo "goleague/api/repositories/match"
 	"goleague/pkg/database/models"
+	"log/slog"
+	"time"
 
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 )
 
+const (
+	// A deferred timeline is requested at most once per match in this window.
+	timelineFetchCooldown = 5 * time.Minute
+	timelineFetchPrefix   = "fetch_match_timeline"
+	redisTimeout          = time.Second
+)
+
+type MatchRedisClient interface {
+	SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd
+}
+
 // MatchService with the  repositories and the gRPC client in case we need to force fetch something (Unlikely).
 type MatchService struct {
 	db              *gorm.DB
+	grpcClient      grpcclient.MatchGRPCClient
+	redis           MatchRedisClient
 	MatchRepository matchrepo.MatchRepository
 }
 
 // MatchServiceDeps is the dependency list for the tierlist service.
 type MatchServiceDeps struct {
-	DB *gorm.DB
+	DB         *gorm.DB
+	GrpcClient grpcclient.MatchGRPCClient
+	Redis      MatchRedisClient
 }
 
 // NewTierlistService creates a tierlist service.
 func NewMatchService(deps *MatchServiceDeps) *MatchService {
 	return &MatchService{
 		db:              deps.DB,
+		grpcClient:      deps.GrpcClient,
+		redis:           deps.Redis,
 		MatchRepository: matchrepo.NewMatchRepository(deps.DB),
 	}
 }
@@ -37,6 +59,12 @@ func (ms *MatchService) GetFullMatchData(ctx context.Context, filter *filters.Ge
 		return nil, err
 	}
 
+	// The timeline may have been deferred during the ingestion, fetch it now that the match is requested.
+	// On failure the match is still returned, just without the timeline.
+	if !match.FullyFetched && ms.grpcClient != nil {
+		ms.fetchDeferredTimeline(ctx, match)
+	}
+
 	matchPreviews, err := ms.MatchRepository.GetMatchPreviewsByInternalId(ctx, match.ID)
 	if err != nil {
 		return nil, err
@@ -66,6 +94,7 @@ func (ms *MatchService) GetFullMatchData(ctx context.Context, filter *filters.Ge
 		ParticipantsPreviews: formattedPreview.Data,
 		ParticipantFrames:    formattedParticipantFrames,
 		Events:               events,
+		TimelinePruned:       match.TimelinePruned,
 	}
 
 	return fullMatch, nil
@@ -75,3 +104,45 @@ func (ms *MatchService) GetFullMatchData(ctx context.Context, filter *filters.Ge
 func (ms *MatchService) GetMatchByMatchId(ctx context.Context, matchId string) (*models.MatchInfo, error) {
 	return ms.MatchRepository.GetMatchByMatchId(ctx, matchId)
 }
+
+// fetchDeferredTimeline requests the deferred timeline of a match to the fetcher.
+// Only matches waiting on the pending timelines are requested, at most once per cooldown.
+func (ms *MatchService) fetchDeferredTimeline(ctx context.Context, match *models.MatchInfo) {
+	pending, err := ms.MatchRepository.HasPendingTimeline(ctx, match.ID)
+	if err != nil {
+		slog.WarnContext(ctx, "Couldn't check the pending match timeline", "match_id", match.MatchId, "error", err)
+		return
+	}
+
+	// Not deferred, the ingestion is still running or the timeline is gone.
+	if !pending {
+		return
+	}
+
+	if !ms.acquireTimelineCooldown(ctx, match.MatchId) {
+		return
+	}
+
+	if _, err := ms.grpcClient.FetchMatchTimeline(ctx, match.MatchId); err != nil {
+		slog.WarnContext(ctx, "Couldn't fetch the deferred match timeline", "match_id", match.MatchId, "error", err)
+	}
+}
+
+// acquireTimelineCooldown checks if the timeline of a match wasn't requested recently, starting the cooldown.
+func (ms *MatchService) acquireTimelineCooldown(ctx context.Context, matchId string) bool {
+	if ms.redis == nil {
+		return true
+	}
+
+	redisCtx, cancelRedis := context.WithTimeout(ctx, redisTimeout)
+	defer cancelRedis()
+
+	key := fmt.Sprintf("%s:%s", timelineFetchPrefix, matchId)
+	acquired, err := ms.redis.SetNX(redisCtx, key, "processing", timelineFetchCooldown).Result()
+	if err != nil {
+		slog.WarnContext(ctx, "Couldn't check the match timeline cooldown", "match_id", matchId, "error", err)
+		return false
+	}
+
+	return acquired
+}
@@ -10,6 +10,7 @@ import (
 	servicetestutil "goleague/api/services/testutil"
 	"goleague/internal/testutil"
 	"goleague/pkg/database/models"
+	pb "goleague/pkg/grpc"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,9 @@ func TestGetFullMatchData(t *testing.T) {
 		filters    *filters.GetFullMatchDataFilter
 
 		mockMatch    *testutil.OperationRestult[*models.MatchInfo]
+		mockPending  *testutil.OperationRestult[bool]
+		mockCooldown *testutil.OperationRestult[bool]
+		mockTimeline *testutil.OperationRestult[*pb.MatchTimelineNotification]
 		mockPreviews *testutil.OperationRestult[[]matchrepo.RawMatchPreview]
 		mockFrames   *testutil.OperationRestult[[]matchrepo.RawMatchParticipantFrame]
 		mockEvents   *testutil.OperationRestult[[]models.AllEvents]
@@ -96,19 +100,94 @@ func TestGetFullMatchData(t *testing.T) {
 			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
 			expectedError: nil,
 		},
+		{
+			name:          "prunedTimeline",
+			returnData:    getExpectedPrunedMatchData(),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockPrunedMatch()),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult([]matchrepo.RawMatchParticipantFrame{}),
+			mockEvents:    testutil.NewSuccessResult([]models.AllEvents{}),
+			expectedError: nil,
+		},
+		{
+			name:          "deferredTimelineFetched",
+			returnData:    loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json"),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockDeferredMatch()),
+			mockPending:   testutil.NewSuccessResult(true),
+			mockCooldown:  testutil.NewSuccessResult(true),
+			mockTimeline:  testutil.NewSuccessResult(&pb.MatchTimelineNotification{Fetched: true}),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult(getMockFrames()),
+			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
+			expectedError: nil,
+		},
+		{
+			name:          "deferredTimelineGrpcError",
+			returnData:    loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json"),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockDeferredMatch()),
+			mockPending:   testutil.NewSuccessResult(true),
+			mockCooldown:  testutil.NewSuccessResult(true),
+			mockTimeline:  testutil.NewErrorResult[*pb.MatchTimelineNotification]("fetcher unavailable"),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult(getMockFrames()),
+			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
+			expectedError: nil,
+		},
+		{
+			name:          "deferredTimelineOnCooldown",
+			returnData:    loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json"),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockDeferredMatch()),
+			mockPending:   testutil.NewSuccessResult(true),
+			mockCooldown:  testutil.NewSuccessResult(false),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult(getMockFrames()),
+			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
+			expectedError: nil,
+		},
+		{
+			name:          "notFetchedWithoutPendingTimeline",
+			returnData:    loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json"),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockDeferredMatch()),
+			mockPending:   testutil.NewSuccessResult(false),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult(getMockFrames()),
+			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
+			expectedError: nil,
+		},
+		{
+			name:          "pendingTimelineDbError",
+			returnData:    loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json"),
+			filters:       defaultFilter,
+			mockMatch:     testutil.NewSuccessResult(getMockDeferredMatch()),
+			mockPending:   testutil.GetMockRepoError[bool](),
+			mockPreviews:  testutil.NewSuccessResult(getMockPreviews()),
+			mockFrames:    testutil.NewSuccessResult(getMockFrames()),
+			mockEvents:    testutil.NewSuccessResult(getMockEvents()),
+			expectedError: nil,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service, mockMatchRepository, mockMatchCache := setupTestService()
+			service, mockMatchRepository, mockMatchCache, mockGRPCClient, mockRedis := setupTestService()
 
 			setupMocks(mockSetup{
 				err:        tt.expectedError,
 				filters:    tt.filters,
+				grpcClient: mockGRPCClient,
 				matchCache: mockMatchCache,
+				redis:      mockRedis,
 
 				repo:         mockMatchRepository,
 				mockMatch:    tt.mockMatch,
+				mockPending:  tt.mockPending,
+				mockCooldown: tt.mockCooldown,
+				mockTimeline: tt.mockTimeline,
 				mockPreviews: tt.mockPreviews,
 				mockFrames:   tt.mockFrames,
 				mockEvents:   tt.mockEvents,
@@ -120,13 +199,13 @@ func TestGetFullMatchData(t *testing.T) {
 
 			assertGetMatchResult(t, result, err, tt.returnData, tt.expectedError)
 
-			servicetestutil.VerifyAllMocks(t, mockMatchCache, mockMatchRepository)
+			servicetestutil.VerifyAllMocks(t, mockMatchCache, mockMatchRepository, mockGRPCClient, mockRedis)
 		})
 	}
 }
 
 func TestGetMatchByMatchId(t *testing.T) {
-	service, mockMatchRepo, _ := setupTestService()
+	service, mockMatchRepo, _, _, _ := setupTestService()
 
 	expectedMatch := &models.MatchInfo{MatchId: "NA1_12345"}
 
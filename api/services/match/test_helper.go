@@ -8,10 +8,12 @@ import (
 	servicetestutil "goleague/api/services/testutil"
 	"goleague/internal/testutil"
 	"goleague/pkg/database/models"
+	pb "goleague/pkg/grpc"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/datatypes"
@@ -22,10 +24,15 @@ import (
 type mockSetup struct {
 	filters *filters.GetFullMatchDataFilter
 
+	grpcClient *servicetestutil.MockMatchGRPCClient
 	matchCache *servicetestutil.MockMatchCache
+	redis      *servicetestutil.MockMatchRedisClient
 	repo       *servicetestutil.MockMatchRepository
 
 	mockMatch    *testutil.OperationRestult[*models.MatchInfo]
+	mockPending  *testutil.OperationRestult[bool]
+	mockCooldown *testutil.OperationRestult[bool]
+	mockTimeline *testutil.OperationRestult[*pb.MatchTimelineNotification]
 	mockPreviews *testutil.OperationRestult[[]matchrepo.RawMatchPreview]
 	mockFrames   *testutil.OperationRestult[[]matchrepo.RawMatchParticipantFrame]
 	mockEvents   *testutil.OperationRestult[[]models.AllEvents]
@@ -40,16 +47,22 @@ func setupTestService() (
 	*MatchService,
 	*servicetestutil.MockMatchRepository,
 	*servicetestutil.MockMatchCache,
+	*servicetestutil.MockMatchGRPCClient,
+	*servicetestutil.MockMatchRedisClient,
 ) {
 	mockMatchRepo := new(servicetestutil.MockMatchRepository)
 	mockMatchCache := new(servicetestutil.MockMatchCache)
+	mockGRPCClient := new(servicetestutil.MockMatchGRPCClient)
+	mockRedis := new(servicetestutil.MockMatchRedisClient)
 
 	service := &MatchService{
 		db:              new(gorm.DB),
+		grpcClient:      mockGRPCClient,
+		redis:           mockRedis,
 		MatchRepository: mockMatchRepo,
 	}
 
-	return service, mockMatchRepo, mockMatchCache
+	return service, mockMatchRepo, mockMatchCache, mockGRPCClient, mockRedis
 }
 
 func setupMocks(setup mockSetup) {
@@ -58,6 +71,20 @@ func setupMocks(setup mockSetup) {
 		setup.repo.On("GetMatchByMatchId", mock.Anything, setup.filters.MatchId).Return(setup.mockMatch.Data, setup.mockMatch.Err)
 	}
 
+	if setup.mockPending != nil {
+		setup.repo.On("HasPendingTimeline", mock.Anything, setup.mockMatch.Data.ID).Return(setup.mockPending.Data, setup.mockPending.Err)
+	}
+
+	if setup.mockCooldown != nil {
+		key := "fetch_match_timeline:" + setup.mockMatch.Data.MatchId
+		setup.redis.On("SetNX", mock.Anything, key, "processing", timelineFetchCooldown).
+			Return(redis.NewBoolResult(setup.mockCooldown.Data, setup.mockCooldown.Err))
+	}
+
+	if setup.mockTimeline != nil {
+		setup.grpcClient.On("FetchMatchTimeline", mock.Anything, setup.mockMatch.Data.MatchId).Return(setup.mockTimeline.Data, setup.mockTimeline.Err)
+	}
+
 	if setup.mockPreviews != nil {
 		setup.repo.On("GetMatchPreviewsByInternalId", mock.Anything, setup.mockMatch.Data.ID).Return(setup.mockPreviews.Data, setup.mockPreviews.Err)
 	}
@@ -90,6 +117,29 @@ func getMockMatch() *models.MatchInfo {
 	}
 }
 
+// Return a mock for a match with the timeline deferred.
+func getMockDeferredMatch() *models.MatchInfo {
+	match := getMockMatch()
+	match.FullyFetched = false
+	return match
+}
+
+// Return a mock for a match with the timeline pruned by the retention.
+func getMockPrunedMatch() *models.MatchInfo {
+	match := getMockMatch()
+	match.TimelinePruned = true
+	return match
+}
+
+// Return the expected data of a match without the timeline.
+func getExpectedPrunedMatchData() *dto.FullMatchData {
+	expected := loadExpectedData[*dto.FullMatchData]("testdata/fullmatch.json")
+	expected.ParticipantFrames = dto.NewParticipantFrameList()
+	expected.Events = []dto.MatchEvents{}
+	expected.TimelinePruned = true
+	return expected
+}
+
 // Return a mock for empty previews return.
 func getEmptyMockPreviews() []matchrepo.RawMatchPreview {
 	return []matchrepo.RawMatchPreview{}
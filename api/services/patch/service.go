@@ -0,0 +1,48 @@
+package patchservice
+
+import (
+	"context"
+	"goleague/api/dto"
+	patchrepo "goleague/api/repositories/patch"
+
+	"gorm.io/gorm"
+)
+
+// PatchService exposes the patches of the stored matches.
+type PatchService struct {
+	db              *gorm.DB
+	PatchRepository patchrepo.PatchRepository
+}
+
+// PatchServiceDeps is the dependency list for the patch service.
+type PatchServiceDeps struct {
+	DB *gorm.DB
+}
+
+// NewPatchService creates a patch service.
+func NewPatchService(deps *PatchServiceDeps) *PatchService {
+	return &PatchService{
+		db:              deps.DB,
+		PatchRepository: patchrepo.NewPatchRepository(deps.DB),
+	}
+}
+
+// GetPatches returns every known patch with the amount of matches, newest first.
+func (ps *PatchService) GetPatches(ctx context.Context) ([]*dto.Patch, error) {
+	patches, err := ps.PatchRepository.GetPatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.Patch, len(patches))
+	for i, patch := range patches {
+		result[i] = &dto.Patch{
+			FirstMatch: patch.FirstMatch,
+			LastMatch:  patch.LastMatch,
+			Matches:    patch.Matches,
+			Patch:      patch.Patch,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package patchservice
+
+import (
+	"context"
+	"errors"
+	patchrepo "goleague/api/repositories/patch"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Simple test for asserting that everything is fine with the patch service creation.
+func TestNewPatchService(t *testing.T) {
+	deps := &PatchServiceDeps{
+		DB: new(gorm.DB),
+	}
+
+	service := NewPatchService(deps)
+	assert.NotNil(t, service)
+	assert.Equal(t, new(gorm.DB), service.db)
+	assert.NotNil(t, service.PatchRepository)
+}
+
+func TestGetPatches(t *testing.T) {
+	tests := []struct {
+		name string
+
+		mockPatches *testutil.OperationRestult[[]patchrepo.PatchMatchCount]
+
+		expectedPatches []string
+		expectedError   error
+	}{
+		{
+			name:          "dbError",
+			mockPatches:   testutil.GetMockRepoError[[]patchrepo.PatchMatchCount](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:            "noMatches",
+			mockPatches:     testutil.NewSuccessResult([]patchrepo.PatchMatchCount{}),
+			expectedPatches: []string{},
+		},
+		{
+			name:            "everythingFine",
+			mockPatches:     testutil.NewSuccessResult(getMockPatches()),
+			expectedPatches: []string{"15.10", "15.9"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockPatchRepo := setupTestService()
+
+			setupMocks(mockSetup{
+				repo:        mockPatchRepo,
+				mockPatches: tt.mockPatches,
+			})
+
+			result, err := service.GetPatches(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, len(tt.expectedPatches))
+			for i, patch := range tt.expectedPatches {
+				assert.Equal(t, patch, result[i].Patch)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockPatchRepo)
+		})
+	}
+}
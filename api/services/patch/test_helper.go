@@ -0,0 +1,49 @@
+package patchservice
+
+import (
+	patchrepo "goleague/api/repositories/patch"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// Mock setup struct
+type mockSetup struct {
+	repo *servicetestutil.MockPatchRepository
+
+	mockPatches *testutil.OperationRestult[[]patchrepo.PatchMatchCount]
+}
+
+// Helper to initialize the mocks.
+func setupTestService() (*PatchService, *servicetestutil.MockPatchRepository) {
+	mockPatchRepo := new(servicetestutil.MockPatchRepository)
+
+	service := &PatchService{
+		db:              new(gorm.DB),
+		PatchRepository: mockPatchRepo,
+	}
+
+	return service, mockPatchRepo
+}
+
+func setupMocks(setup mockSetup) {
+	if setup.mockPatches != nil {
+		setup.repo.On("GetPatches", mock.Anything).Return(setup.mockPatches.Data, setup.mockPatches.Err)
+	}
+}
+
+// Return a fixed time for the mocks.
+func getMockTime() time.Time {
+	return time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+}
+
+// Return mocked patches, already sorted from the newest.
+func getMockPatches() []patchrepo.PatchMatchCount {
+	return []patchrepo.PatchMatchCount{
+		{Patch: "15.10", Matches: 1200, FirstMatch: getMockTime(), LastMatch: getMockTime().AddDate(0, 0, 14)},
+		{Patch: "15.9", Matches: 8500, FirstMatch: getMockTime().AddDate(0, 0, -14), LastMatch: getMockTime()},
+	}
+}
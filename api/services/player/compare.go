@@ -0,0 +1,108 @@
+package playerservice
+
+import (
+	"goleague/api/dto"
+	playerrepo "goleague/api/repositories/player"
+	"slices"
+	"strconv"
+)
+
+// Queue key of the stats of every queue.
+const allQueuesKey = "ALL"
+
+// sharedChampions returns the champions on the stats of every player, ordered by the champion id.
+func sharedChampions(players []*dto.ComparedPlayer) []*dto.SharedChampion {
+	result := []*dto.SharedChampion{}
+
+	first := queueStats(players[0])
+	if first == nil {
+		return result
+	}
+
+	championKeys := make([]string, 0, len(first.ChampionData))
+	for championKey := range first.ChampionData {
+		championKeys = append(championKeys, championKey)
+	}
+
+	for _, championKey := range championKeys {
+		championId, err := strconv.Atoi(championKey)
+		if err != nil {
+			continue
+		}
+
+		shared := &dto.SharedChampion{ChampionId: championId, Stats: make([]*dto.StatsEntry, len(players))}
+		for i, player := range players {
+			stats := queueStats(player)
+			if stats == nil || stats.ChampionData[championKey] == nil {
+				shared = nil
+				break
+			}
+			shared.Stats[i] = stats.ChampionData[championKey]
+		}
+
+		if shared != nil {
+			result = append(result, shared)
+		}
+	}
+
+	slices.SortFunc(result, func(a, b *dto.SharedChampion) int {
+		return a.ChampionId - b.ChampionId
+	})
+
+	return result
+}
+
+// queueStats returns the stats of a player on every queue, nil without matches.
+func queueStats(player *dto.ComparedPlayer) *dto.PlayerStatsQueue {
+	if player.Stats == nil {
+		return nil
+	}
+	return player.Stats[allQueuesKey]
+}
+
+// countEncounters counts the matches of each pair of players, the participants must be ordered by match.
+func countEncounters(players []*dto.ComparedPlayer, participants []playerrepo.SharedMatchParticipant) []*dto.PlayerEncounter {
+	encounters := make([]*dto.PlayerEncounter, 0, len(players)*(len(players)-1)/2)
+	pairs := make(map[[2]uint]*dto.PlayerEncounter)
+	for i := range players {
+		for j := i + 1; j < len(players); j++ {
+			encounter := &dto.PlayerEncounter{FirstPlayerId: players[i].Id, SecondPlayerId: players[j].Id}
+			encounters = append(encounters, encounter)
+			pairs[[2]uint{players[i].Id, players[j].Id}] = encounter
+		}
+	}
+
+	for start := 0; start < len(participants); {
+		end := start
+		for end < len(participants) && participants[end].MatchId == participants[start].MatchId {
+			end++
+		}
+
+		match := participants[start:end]
+		for _, first := range match {
+			for _, second := range match {
+				encounter, exists := pairs[[2]uint{first.PlayerId, second.PlayerId}]
+				if !exists {
+					continue
+				}
+
+				if first.TeamId == second.TeamId {
+					encounter.Together++
+					if first.Win {
+						encounter.TogetherWins++
+					}
+					continue
+				}
+
+				encounter.Against++
+				if first.Win {
+					encounter.AgainstWins++
+				}
+			}
+		}
+
+		start = end
+	}
+
+	return encounters
+}
@@ -0,0 +1,70 @@
+package playerservice
+
+import (
+	"goleague/api/converters"
+	"goleague/api/dto"
+	playerrepo "goleague/api/repositories/player"
+	"sort"
+)
+
+const (
+	// Teammates met only once are random matchmaking.
+	duoMinMatches = 2
+	// Consecutive matches on the same team needed to consider the partner a premade.
+	duoPremadeMinStreak = 2
+	duoPartnersLimit    = 10
+)
+
+// aggregateDuoPartners counts the matches with each teammate, the matches must be grouped by partner and ordered by index.
+func aggregateDuoPartners(matches []playerrepo.DuoMatch) []*dto.DuoPartner {
+	partners := []*dto.DuoPartner{}
+
+	var current *dto.DuoPartner
+	var lastIndex, streak int
+	for _, match := range matches {
+		if current == nil || current.Id != match.PartnerId {
+			current = &dto.DuoPartner{
+				Id:          match.PartnerId,
+				Name:        match.RiotIdGameName,
+				Tag:         match.RiotIdTagline,
+				ProfileIcon: match.ProfileIcon,
+			}
+			partners = append(partners, current)
+			streak = 0
+		}
+
+		if streak > 0 && match.MatchIndex == lastIndex+1 {
+			streak++
+		} else {
+			streak = 1
+		}
+		lastIndex = match.MatchIndex
+
+		current.Matches++
+		if match.Win {
+			current.Wins++
+		}
+		current.ConsecutiveGames = max(current.ConsecutiveGames, streak)
+	}
+
+	result := []*dto.DuoPartner{}
+	for _, partner := range partners {
+		if partner.Matches < duoMinMatches {
+			continue
+		}
+
+		partner.WinRate = converters.Percentage(partner.Wins, partner.Matches)
+		partner.LikelyPremade = partner.ConsecutiveGames >= duoPremadeMinStreak
+		result = append(result, partner)
+	}
+
+	// The partners come ordered by id, so the ties keep the same order.
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Matches != result[j].Matches {
+			return result[i].Matches > result[j].Matches
+		}
+		return result[i].WinRate > result[j].WinRate
+	})
+
+	return result[:min(len(result), duoPartnersLimit)]
+}
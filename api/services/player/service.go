@@ -12,7 +12,9 @@ import (
 	grpcclient "goleague/api/grpc"
 	matchrepo "goleague/api/repositories/match"
 	playerrepo "goleague/api/repositories/player"
+	"goleague/pkg/database/models"
 	"goleague/pkg/messages"
+	"goleague/pkg/tracing"
 	"strconv"
 	"strings"
 	"time"
@@ -131,8 +133,9 @@ func (ps *PlayerService) GetPlayerSearch(ctx context.Context, filters *filters.P
 	return playerDto, nil
 }
 
-// GetPlayerMatchHistory returns a player match list based on filters.
-func (ps *PlayerService) GetPlayerMatchHistory(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) (dto.MatchPreviewList, error) {
+// GetPlayerMatchHistory returns a page of the player match list based on filters.
+// The cursor of the next page is nil on the last page.
+func (ps *PlayerService) GetPlayerMatchHistory(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) (*dto.MatchHistoryPage, error) {
 	// Convert to string.
 	// Received through path params.
 	name := filters.GameName
@@ -145,15 +148,164 @@ func (ps *PlayerService) GetPlayerMatchHistory(ctx context.Context, filters *fil
 	}
 
 	filters.PlayerId = &player.ID
-	matchesIds, err := ps.PlayerRepository.GetPlayerMatchHistoryIds(ctx, filters)
+	entries, err := ps.PlayerRepository.GetPlayerMatchHistoryEntries(ctx, filters)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get the match ids: %w", err)
 	}
 
-	if len(matchesIds) == 0 {
-		return nil, nil
+	page := &dto.MatchHistoryPage{}
+	if len(entries) == 0 {
+		return page, nil
+	}
+
+	// The extra match only signals that there is a next page.
+	if len(entries) > filters.PageSize {
+		entries = entries[:filters.PageSize]
+		cursor := encodeMatchHistoryCursor(entries[len(entries)-1])
+		page.NextCursor = &cursor
+	}
+
+	matchesIds := make([]uint, len(entries))
+	for i, entry := range entries {
+		matchesIds[i] = entry.Id
+	}
+
+	page.Matches, err = ps.getMatchPreviews(ctx, matchesIds)
+	if err != nil {
+		return nil, err
+	}
+
+	// The previews are a map, so the order of the history is sent on the ids.
+	matchIdsByInternalId := make(map[uint]string, len(page.Matches))
+	for matchId, preview := range page.Matches {
+		if preview.Metadata != nil {
+			matchIdsByInternalId[preview.Metadata.InternalId] = matchId
+		}
+	}
+
+	page.Ids = make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if matchId, exists := matchIdsByInternalId[entry.Id]; exists {
+			page.Ids = append(page.Ids, matchId)
+		}
+	}
+
+	return page, nil
+}
+
+// GetPlayerSummary summarizes the last matches of a player, using the cached match previews.
+func (ps *PlayerService) GetPlayerSummary(ctx context.Context, filters *filters.PlayerSummaryFilter) (*dto.RecentSummary, error) {
+	player, err := ps.PlayerRepository.GetPlayerByNameTagRegion(ctx, filters.GameName, filters.GameTag, filters.Region)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CouldNotFindId+": %w", "player", err)
+	}
+
+	filters.PlayerId = &player.ID
+	entries, err := ps.PlayerRepository.GetPlayerMatchHistoryEntries(ctx, filters.HistoryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the recent matches: %w", err)
+	}
+
+	// The history returns an extra match to signal the next page.
+	entries = entries[:min(len(entries), filters.Count)]
+	if len(entries) == 0 {
+		return summarizeRecentMatches(player.ID, entries, nil), nil
+	}
+
+	matchesIds := make([]uint, len(entries))
+	for i, entry := range entries {
+		matchesIds[i] = entry.Id
+	}
+
+	matchPreviews, err := ps.getMatchPreviews(ctx, matchesIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizeRecentMatches(player.ID, entries, matchPreviews), nil
+}
+
+// GetPlayerComparison compares the ratings, stats and shared matches of the players.
+// Each step is a single query for every player, so the comparison doesn't grow in queries with the players.
+func (ps *PlayerService) GetPlayerComparison(ctx context.Context, filters *filters.PlayerCompareFilter) (*dto.PlayerComparison, error) {
+	infos, err := ps.PlayerRepository.GetPlayersByNameTagRegion(ctx, filters.Players)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CouldNotFindId+": %w", "player", err)
+	}
+
+	infosByKey := make(map[string]*models.PlayerInfo, len(infos))
+	for _, info := range infos {
+		infosByKey[comparedPlayerKey(info.RiotIdGameName, info.RiotIdTagline, string(info.Region))] = info
+	}
+
+	players := make([]*dto.ComparedPlayer, len(filters.Players))
+	playerIds := make([]uint, len(filters.Players))
+	for i, player := range filters.Players {
+		info, exists := infosByKey[comparedPlayerKey(player.GameName, player.GameTag, player.Region)]
+		if !exists {
+			return nil, fmt.Errorf("%s#%s: "+messages.CouldNotFindId, player.GameName, player.GameTag, "player")
+		}
+
+		players[i] = &dto.ComparedPlayer{
+			Id:          info.ID,
+			Name:        info.RiotIdGameName,
+			ProfileIcon: info.ProfileIcon,
+			Region:      string(info.Region),
+			Tag:         info.RiotIdTagline,
+			Rating:      []dto.RatingInfo{},
+		}
+		playerIds[i] = info.ID
+	}
+
+	ratings, err := ps.PlayerRepository.GetPlayersRatingsByIds(ctx, playerIds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the player ratings: %w", err)
+	}
+
+	playerStats, err := ps.PlayerRepository.GetPlayersStats(ctx, playerIds, filters.Since, filters.Patch)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the player stats: %w", err)
+	}
+
+	for _, player := range players {
+		for _, rating := range ratings {
+			if rating.PlayerId == player.Id {
+				player.Rating = append(player.Rating, newRatingInfo(rating))
+			}
+		}
+
+		for _, stats := range playerStats {
+			if stats.PlayerId != player.Id {
+				continue
+			}
+
+			if player.Stats == nil {
+				player.Stats = make(dto.FullPlayerStats)
+			}
+			parsePlayerStats(player.Stats, stats)
+		}
+	}
+
+	participants, err := ps.PlayerRepository.GetSharedMatches(ctx, playerIds, filters.Since, filters.Patch)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the shared matches: %w", err)
 	}
 
+	return &dto.PlayerComparison{
+		Players:         players,
+		SharedChampions: sharedChampions(players),
+		Encounters:      countEncounters(players, participants),
+	}, nil
+}
+
+// comparedPlayerKey identifies a compared player, the names are matched as stored.
+func comparedPlayerKey(name string, tag string, region string) string {
+	return name + "#" + tag + "/" + strings.ToUpper(region)
+}
+
+// getMatchPreviews returns the previews of the matches, from the cache when possible.
+// The missing previews are loaded from the database and cached.
+func (ps *PlayerService) getMatchPreviews(ctx context.Context, matchesIds []uint) (dto.MatchPreviewList, error) {
 	cachedMatches, missingMatches := ps.getCachedMatchPreviews(ctx, matchesIds)
 
 	// All previews cached.
@@ -191,6 +343,14 @@ func (ps *PlayerService) GetPlayerMatchHistory(ctx context.Context, filters *fil
 	return formattedPreviews, nil
 }
 
+// encodeMatchHistoryCursor returns the cursor of the page after the given match.
+func encodeMatchHistoryCursor(entry playerrepo.MatchHistoryEntry) string {
+	return filters.EncodeMatchHistoryCursor(filters.MatchHistoryCursor{
+		MatchStart: entry.MatchStart,
+		MatchId:    entry.Id,
+	})
+}
+
 // getCachedMatchPreviews return the cached raw match previews for the provided match ids.
 func (ps *PlayerService) getCachedMatchPreviews(ctx context.Context, matchesIds []uint) ([]dto.MatchPreview, []uint) {
 	cacheCtx, cancel := context.WithTimeout(ctx, matchPreviewCacheTimeout)
@@ -235,15 +395,7 @@ func (ps *PlayerService) GetPlayerInfo(ctx context.Context, filters *filters.Pla
 	// Add the rating entries for the player (If any)
 	ratings := make([]dto.RatingInfo, len(playerRatings))
 	for key, rating := range playerRatings {
-		ratings[key] = dto.RatingInfo{
-			LeaguePoints: rating.LeaguePoints,
-			Losses:       rating.Losses,
-			Queue:        rating.Queue,
-			Rank:         rating.Rank,
-			Region:       string(rating.Region),
-			Tier:         rating.Tier,
-			Wins:         rating.Wins,
-		}
+		ratings[key] = newRatingInfo(rating)
 	}
 
 	fullPlayerInfo.Rating = ratings
@@ -251,6 +403,19 @@ func (ps *PlayerService) GetPlayerInfo(ctx context.Context, filters *filters.Pla
 	return &fullPlayerInfo, nil
 }
 
+// newRatingInfo converts a rating entry to the rating of the player info.
+func newRatingInfo(rating models.RatingEntry) dto.RatingInfo {
+	return dto.RatingInfo{
+		LeaguePoints: rating.LeaguePoints,
+		Losses:       rating.Losses,
+		Queue:        rating.Queue,
+		Rank:         rating.Rank,
+		Region:       string(rating.Region),
+		Tier:         rating.Tier,
+		Wins:         rating.Wins,
+	}
+}
+
 // GetPlayerStats returns the player stats for a given player.
 func (ps *PlayerService) GetPlayerStats(ctx context.Context, filters *filters.PlayerStatsFilter) (dto.FullPlayerStats, error) {
 	name := filters.GameName
@@ -281,10 +446,80 @@ func (ps *PlayerService) GetPlayerStats(ctx context.Context, filters *filters.Pl
 	return playerStatsDto, nil
 }
 
+// GetPlayerRatingHistory returns the rating timeline of a player on a queue, with the peak of each season.
+func (ps *PlayerService) GetPlayerRatingHistory(ctx context.Context, filters *filters.PlayerRatingHistoryFilter) (*dto.RatingHistory, error) {
+	player, err := ps.PlayerRepository.GetPlayerByNameTagRegion(ctx, filters.GameName, filters.GameTag, filters.Region)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CouldNotFindId+": %w", "player", err)
+	}
+
+	filters.PlayerId = &player.ID
+	ratings, err := ps.PlayerRepository.GetPlayerRatingHistory(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the player rating history: %w", err)
+	}
+
+	peaks, err := ps.PlayerRepository.GetPlayerRatingPeaks(ctx, player.ID, filters.Queue)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the player rating peaks: %w", err)
+	}
+
+	history := &dto.RatingHistory{
+		Queue:   filters.Queue,
+		Daily:   filters.Daily,
+		Entries: make([]dto.RatingHistoryEntry, len(ratings)),
+		Peaks:   make([]dto.RatingPeak, len(peaks)),
+	}
+
+	for i, rating := range ratings {
+		history.Entries[i] = dto.RatingHistoryEntry{
+			Tier:         rating.Tier,
+			Rank:         rating.Rank,
+			LeaguePoints: rating.LeaguePoints,
+			NumericScore: rating.NumericScore,
+			Wins:         rating.Wins,
+			Losses:       rating.Losses,
+			FetchTime:    rating.FetchTime,
+		}
+	}
+
+	for i, peak := range peaks {
+		history.Peaks[i] = dto.RatingPeak{
+			Season:       peak.Season,
+			Tier:         peak.Tier,
+			Rank:         peak.Rank,
+			LeaguePoints: peak.LeaguePoints,
+			NumericScore: peak.NumericScore,
+			FetchTime:    peak.FetchTime,
+		}
+	}
+
+	return history, nil
+}
+
+// GetPlayerDuoPartners returns the players that most often played on the same team as a player on the recent matches.
+func (ps *PlayerService) GetPlayerDuoPartners(ctx context.Context, filters *filters.PlayerDuoFilter) ([]*dto.DuoPartner, error) {
+	player, err := ps.PlayerRepository.GetPlayerByNameTagRegion(ctx, filters.GameName, filters.GameTag, filters.Region)
+	if err != nil {
+		return nil, fmt.Errorf(messages.CouldNotFindId+": %w", "player", err)
+	}
+
+	filters.PlayerId = &player.ID
+	matches, err := ps.PlayerRepository.GetPlayerDuoMatches(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get the player duo matches: %w", err)
+	}
+
+	return aggregateDuoPartners(matches), nil
+}
+
 // checkGRPCRateLimit verifies the gRPC calls rate limit.
-func (ps *PlayerService) checkGRPCRateLimit(gameName string, gameTag string, region string, operation string) error {
+func (ps *PlayerService) checkGRPCRateLimit(ctx context.Context, gameName string, gameTag string, region string, operation string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "redis.cooldown")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	rateLimitKey := ps.createPlayerRateLimitKey(gameName, gameTag, region, operation)
-	redisCtx, cancelRedis := context.WithTimeout(context.Background(), matchPreviewCacheTimeout)
+	redisCtx, cancelRedis := context.WithTimeout(ctx, matchPreviewCacheTimeout)
 	defer cancelRedis()
 
 	return ps.checkRateLimit(redisCtx, rateLimitKey, gRPCCallCooldown)
@@ -292,7 +527,7 @@ func (ps *PlayerService) checkGRPCRateLimit(gameName string, gameTag string, reg
 
 // ForceFetchPlayer makes a gRPC requets to the fetcher to forcefully get data from a Player.
 func (ps *PlayerService) ForceFetchPlayer(ctx context.Context, filters *filters.PlayerForceFetchFilter) (*pb.Summoner, error) {
-	if err := ps.checkGRPCRateLimit(filters.GameName, filters.GameTag, filters.Region, FORCE_FETCH_OPERATION); err != nil {
+	if err := ps.checkGRPCRateLimit(ctx, filters.GameName, filters.GameTag, filters.Region, FORCE_FETCH_OPERATION); err != nil {
 		return nil, err
 	}
 	return ps.grpcClient.ForceFetchPlayer(ctx, filters, FORCE_FETCH_OPERATION)
@@ -300,7 +535,7 @@ func (ps *PlayerService) ForceFetchPlayer(ctx context.Context, filters *filters.
 
 // ForceFetchPlayer makes a gRPC requets to the fetcher to forcefully get data from a Player.
 func (ps *PlayerService) ForceFetchPlayerMatchHistory(ctx context.Context, filters *filters.PlayerForceFetchMatchListFilter) (*pb.MatchHistoryFetchNotification, error) {
-	if err := ps.checkGRPCRateLimit(filters.GameName, filters.GameTag, filters.Region, FORCE_FETCH_MATCHES_OPERATION); err != nil {
+	if err := ps.checkGRPCRateLimit(ctx, filters.GameName, filters.GameTag, filters.Region, FORCE_FETCH_MATCHES_OPERATION); err != nil {
 		return nil, err
 	}
 	return ps.grpcClient.ForceFetchPlayerMatchHistory(ctx, filters, FORCE_FETCH_MATCHES_OPERATION)
@@ -348,6 +583,12 @@ func parsePlayerStats(playerStatsDto dto.FullPlayerStats, stats playerrepo.RawPl
 		KDA:            stats.KDA,
 		Matches:        stats.Matches,
 		WinRate:        stats.WinRate,
+		GoldDiffAt10:   stats.GoldDiffAt10,
+		XpDiffAt10:     stats.XpDiffAt10,
+		CsDiffAt10:     stats.CsDiffAt10,
+		GoldDiffAt15:   stats.GoldDiffAt15,
+		XpDiffAt15:     stats.XpDiffAt15,
+		CsDiffAt15:     stats.CsDiffAt15,
 	}
 
 	// Only add the entries with no champion or lane filter.
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"goleague/api/dto"
 	"goleague/api/filters"
@@ -151,7 +152,10 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 		name           string
 		filter         *filters.PlayerMatchHistoryFilter
 		playerInfo     *testutil.OperationRestult[*models.PlayerInfo]
-		matchIds       *testutil.OperationRestult[[]uint]
+		matchEntries   *testutil.OperationRestult[[]playerrepo.MatchHistoryEntry]
+		pageIds        []uint
+		expectedCursor *string
+		expectedIds    []string
 		cachedMatches  *testutil.OperationRestult[[]dto.MatchPreview]
 		missingMatches []uint
 		rawPreviews    *testutil.OperationRestult[[]matchrepo.RawMatchPreview]
@@ -163,19 +167,22 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
-			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
-			matchIds:   testutil.NewSuccessResult([]uint{1, 2}),
+			playerInfo:   testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			matchEntries: testutil.NewSuccessResult(getMockHistoryEntries(1, 2)),
+			pageIds:      []uint{1, 2},
 			cachedMatches: testutil.NewSuccessResult([]dto.MatchPreview{
 				{
-					Metadata: &dto.MatchPreviewMetadata{MatchId: "match1"},
+					Metadata: &dto.MatchPreviewMetadata{MatchId: "match1", InternalId: 1},
 				},
 				{
-					Metadata: &dto.MatchPreviewMetadata{MatchId: "match2"},
+					Metadata: &dto.MatchPreviewMetadata{MatchId: "match2", InternalId: 2},
 				},
 			}),
 			rawPreviews:    testutil.NewSuccessResult([]matchrepo.RawMatchPreview{}),
 			missingMatches: []uint{},
+			expectedIds:    []string{"match1", "match2"},
 			expectedError:  "",
 		},
 		{
@@ -184,6 +191,7 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
 			playerInfo:    testutil.NewErrorResult[*models.PlayerInfo](gorm.ErrRecordNotFound.Error()),
 			expectedError: fmt.Sprintf(messages.CouldNotFindId, "player"),
@@ -194,9 +202,10 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
 			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
-			matchIds:      testutil.NewSuccessResult([]uint{}),
+			matchEntries:  testutil.NewSuccessResult([]playerrepo.MatchHistoryEntry{}),
 			expectedError: "",
 		},
 		{
@@ -205,9 +214,10 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
 			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
-			matchIds:      testutil.NewErrorResult[[]uint](gorm.ErrInvalidData.Error()),
+			matchEntries:  testutil.NewErrorResult[[]playerrepo.MatchHistoryEntry](gorm.ErrInvalidData.Error()),
 			expectedError: "unsupported data",
 		},
 		{
@@ -216,12 +226,14 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
-			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
-			matchIds:   testutil.NewSuccessResult([]uint{1, 2}),
+			playerInfo:   testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			matchEntries: testutil.NewSuccessResult(getMockHistoryEntries(1, 2)),
+			pageIds:      []uint{1, 2},
 			cachedMatches: testutil.NewSuccessResult([]dto.MatchPreview{
 				{
-					Metadata: &dto.MatchPreviewMetadata{MatchId: "match1"},
+					Metadata: &dto.MatchPreviewMetadata{MatchId: "match1", InternalId: 1},
 				},
 			}),
 			missingMatches: []uint{2},
@@ -234,14 +246,42 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				GameName: "TestPlayer",
 				GameTag:  "TAG1",
 				Region:   "NA1",
+				PageSize: 10,
 			},
 			playerInfo:     testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
-			matchIds:       testutil.NewSuccessResult([]uint{1, 2}),
+			matchEntries:   testutil.NewSuccessResult(getMockHistoryEntries(1, 2)),
+			pageIds:        []uint{1, 2},
 			cachedMatches:  testutil.NewErrorResult[[]dto.MatchPreview]("cache error"),
 			missingMatches: []uint{1, 2},
 			expectedError:  "",
 			rawPreviews:    testutil.NewSuccessResult([]matchrepo.RawMatchPreview{}),
 		},
+		{
+			name: "successful with a next page",
+			filter: &filters.PlayerMatchHistoryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				PageSize: 2,
+			},
+			playerInfo:   testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			matchEntries: testutil.NewSuccessResult(getMockHistoryEntries(5, 4, 3)),
+			pageIds:      []uint{5, 4},
+			cachedMatches: testutil.NewSuccessResult([]dto.MatchPreview{
+				{
+					Metadata: &dto.MatchPreviewMetadata{MatchId: "match4", InternalId: 4},
+				},
+				{
+					Metadata: &dto.MatchPreviewMetadata{MatchId: "match5", InternalId: 5},
+				},
+			}),
+			missingMatches: []uint{},
+			expectedIds:    []string{"match5", "match4"},
+			expectedCursor: func() *string {
+				cursor := filters.EncodeMatchHistoryCursor(filters.MatchHistoryCursor{MatchStart: getMockHistoryStart(4), MatchId: 4})
+				return &cursor
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -255,17 +295,18 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 					GameTag:  tt.filter.GameTag,
 					Region:   tt.filter.Region,
 					PlayerId: &tt.playerInfo.Data.ID,
+					PageSize: tt.filter.PageSize,
 				}
-				mockPlayerRepo.On("GetPlayerMatchHistoryIds", mock.Anything, expectedFilter).
-					Return(tt.matchIds.Data, tt.matchIds.Err).Once()
+				mockPlayerRepo.On("GetPlayerMatchHistoryEntries", mock.Anything, expectedFilter).
+					Return(tt.matchEntries.Data, tt.matchEntries.Err).Once()
 
-				if len(tt.matchIds.Data) > 0 {
-					mockMatchCache.On("GetMatchesPreviewByMatchIds", mock.Anything, tt.matchIds.Data).
+				if len(tt.matchEntries.Data) > 0 {
+					mockMatchCache.On("GetMatchesPreviewByMatchIds", mock.Anything, tt.pageIds).
 						Return(tt.cachedMatches.Data, tt.missingMatches, tt.cachedMatches.Err).Once()
 
 					// Cache failed, need to presume all matches are missing on cache.
 					if tt.cachedMatches.Err != nil {
-						assert.Equal(t, len(tt.missingMatches), len(tt.matchIds.Data))
+						assert.Equal(t, len(tt.missingMatches), len(tt.pageIds))
 					}
 
 					if len(tt.missingMatches) > 0 {
@@ -282,10 +323,16 @@ func TestGetPlayerMatchHistory(t *testing.T) {
 				assert.Contains(t, err.Error(), tt.expectedError)
 			} else {
 				assert.NoError(t, err)
-				if len(tt.matchIds.Data) == 0 {
-					assert.Nil(t, result)
+				assert.Equal(t, tt.expectedCursor, result.NextCursor)
+				if len(tt.matchEntries.Data) == 0 {
+					assert.Nil(t, result.Matches)
 				} else {
-					assert.NotNil(t, result)
+					assert.NotNil(t, result.Matches)
+				}
+
+				// The ids follow the history order, not the order of the cache.
+				if tt.expectedIds != nil {
+					assert.Equal(t, tt.expectedIds, result.Ids)
 				}
 			}
 
@@ -561,3 +608,470 @@ func TestGetPlayerStats(t *testing.T) {
 		})
 	}
 }
+
+// Test that the laning differences are kept per champion and per lane.
+func TestParsePlayerStatsLaningDiffs(t *testing.T) {
+	goldDiff, xpDiff, csDiff := float32(350.5), float32(-120), float32(8.25)
+
+	playerStats := make(dto.FullPlayerStats)
+	parsePlayerStats(playerStats, playerrepo.RawPlayerStatsStruct{
+		ChampionId:       103,
+		TeamPosition:     "ALL",
+		QueueId:          420,
+		AggregationLevel: "by_queue_champion",
+		Matches:          10,
+		GoldDiffAt10:     &goldDiff,
+		XpDiffAt10:       &xpDiff,
+		CsDiffAt15:       &csDiff,
+	})
+	// Matches without a timeline have no differences.
+	parsePlayerStats(playerStats, playerrepo.RawPlayerStatsStruct{
+		ChampionId:       -1,
+		TeamPosition:     "MIDDLE",
+		QueueId:          420,
+		AggregationLevel: "by_queue_position",
+		Matches:          4,
+	})
+
+	champion := playerStats["420"].ChampionData["103"]
+	assert.Equal(t, &goldDiff, champion.GoldDiffAt10)
+	assert.Equal(t, &xpDiff, champion.XpDiffAt10)
+	assert.Equal(t, &csDiff, champion.CsDiffAt15)
+	assert.Nil(t, champion.CsDiffAt10)
+
+	lane := playerStats["420"].LaneData["MIDDLE"]
+	assert.Equal(t, 4, lane.Matches)
+	assert.Nil(t, lane.GoldDiffAt10)
+	assert.Nil(t, lane.GoldDiffAt15)
+}
+
+// Test a fetch for a given player rating history.
+func TestGetPlayerRatingHistory(t *testing.T) {
+	service, mockPlayerRepo, _, _, _, _ := setupTestService()
+
+	fetchTime := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		filter        *filters.PlayerRatingHistoryFilter
+		playerInfo    *testutil.OperationRestult[*models.PlayerInfo]
+		ratings       *testutil.OperationRestult[[]models.RatingEntry]
+		peaks         *testutil.OperationRestult[[]playerrepo.RatingPeak]
+		expectedError string
+	}{
+		{
+			name: "successful rating history retrieval",
+			filter: &filters.PlayerRatingHistoryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				Queue:    "RANKED_SOLO_5x5",
+				Daily:    true,
+			},
+			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			ratings: testutil.NewSuccessResult([]models.RatingEntry{
+				{Tier: "GOLD", Rank: "II", LeaguePoints: 80, NumericScore: 35080, Wins: 20, Losses: 18, FetchTime: fetchTime},
+				{Tier: "GOLD", Rank: "I", LeaguePoints: 5, NumericScore: 37505, Wins: 22, Losses: 18, FetchTime: fetchTime.AddDate(0, 0, 1)},
+			}),
+			peaks: testutil.NewSuccessResult([]playerrepo.RatingPeak{
+				{Season: 2025, Tier: "GOLD", Rank: "I", LeaguePoints: 5, NumericScore: 37505, FetchTime: fetchTime.AddDate(0, 0, 1)},
+			}),
+		},
+		{
+			name: "player not found",
+			filter: &filters.PlayerRatingHistoryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo:    testutil.NewErrorResult[*models.PlayerInfo](gorm.ErrRecordNotFound.Error()),
+			expectedError: fmt.Sprintf(messages.CouldNotFindId, "player"),
+		},
+		{
+			name: "rating history error",
+			filter: &filters.PlayerRatingHistoryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			ratings:       testutil.NewErrorResult[[]models.RatingEntry](gorm.ErrInvalidDB.Error()),
+			expectedError: "couldn't get the player rating history",
+		},
+		{
+			name: "rating peaks error",
+			filter: &filters.PlayerRatingHistoryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			ratings:       testutil.NewSuccessResult([]models.RatingEntry{}),
+			peaks:         testutil.NewErrorResult[[]playerrepo.RatingPeak](gorm.ErrInvalidDB.Error()),
+			expectedError: "couldn't get the player rating peaks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlayerRepo.On("GetPlayerByNameTagRegion", mock.Anything, tt.filter.GameName, tt.filter.GameTag, tt.filter.Region).
+				Return(tt.playerInfo.Data, tt.playerInfo.Err).Once()
+
+			if tt.ratings != nil {
+				mockPlayerRepo.On("GetPlayerRatingHistory", mock.Anything, tt.filter).
+					Return(tt.ratings.Data, tt.ratings.Err).Once()
+			}
+
+			if tt.peaks != nil {
+				mockPlayerRepo.On("GetPlayerRatingPeaks", mock.Anything, tt.playerInfo.Data.ID, tt.filter.Queue).
+					Return(tt.peaks.Data, tt.peaks.Err).Once()
+			}
+
+			result, err := service.GetPlayerRatingHistory(context.Background(), tt.filter)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.filter.Queue, result.Queue)
+				assert.True(t, result.Daily)
+				assert.Len(t, result.Entries, len(tt.ratings.Data))
+				assert.Equal(t, 37505, result.Entries[1].NumericScore)
+				assert.Len(t, result.Peaks, 1)
+				assert.Equal(t, 2025, result.Peaks[0].Season)
+			}
+
+			mockPlayerRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetPlayerDuoPartners(t *testing.T) {
+	service, mockPlayerRepo, _, _, _, _ := setupTestService()
+
+	tests := []struct {
+		name          string
+		filter        *filters.PlayerDuoFilter
+		playerInfo    *testutil.OperationRestult[*models.PlayerInfo]
+		duoMatches    *testutil.OperationRestult[[]playerrepo.DuoMatch]
+		expected      []*dto.DuoPartner
+		expectedError string
+	}{
+		{
+			name: "successful duo partners retrieval",
+			filter: &filters.PlayerDuoFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			duoMatches: testutil.NewSuccessResult([]playerrepo.DuoMatch{
+				// Met twice, but never on consecutive matches.
+				{PartnerId: 2, RiotIdGameName: "Random", MatchIndex: 1, Win: true},
+				{PartnerId: 2, RiotIdGameName: "Random", MatchIndex: 5, Win: false},
+				// Met only once.
+				{PartnerId: 3, RiotIdGameName: "Once", MatchIndex: 2, Win: true},
+				// Queued together on three matches in a row.
+				{PartnerId: 4, RiotIdGameName: "Duo", MatchIndex: 2, Win: true},
+				{PartnerId: 4, RiotIdGameName: "Duo", MatchIndex: 3, Win: true},
+				{PartnerId: 4, RiotIdGameName: "Duo", MatchIndex: 4, Win: false},
+				{PartnerId: 4, RiotIdGameName: "Duo", MatchIndex: 6, Win: true},
+			}),
+			expected: []*dto.DuoPartner{
+				{Id: 4, Name: "Duo", Matches: 4, Wins: 3, WinRate: 75, ConsecutiveGames: 3, LikelyPremade: true},
+				{Id: 2, Name: "Random", Matches: 2, Wins: 1, WinRate: 50, ConsecutiveGames: 1, LikelyPremade: false},
+			},
+		},
+		{
+			name: "no recent matches",
+			filter: &filters.PlayerDuoFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			duoMatches: testutil.NewSuccessResult([]playerrepo.DuoMatch{}),
+			expected:   []*dto.DuoPartner{},
+		},
+		{
+			name: "player not found",
+			filter: &filters.PlayerDuoFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo:    testutil.NewErrorResult[*models.PlayerInfo](gorm.ErrRecordNotFound.Error()),
+			expectedError: fmt.Sprintf(messages.CouldNotFindId, "player"),
+		},
+		{
+			name: "duo matches error",
+			filter: &filters.PlayerDuoFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+			},
+			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			duoMatches:    testutil.NewErrorResult[[]playerrepo.DuoMatch](gorm.ErrInvalidDB.Error()),
+			expectedError: "couldn't get the player duo matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlayerRepo.On("GetPlayerByNameTagRegion", mock.Anything, tt.filter.GameName, tt.filter.GameTag, tt.filter.Region).
+				Return(tt.playerInfo.Data, tt.playerInfo.Err).Once()
+
+			if tt.duoMatches != nil {
+				mockPlayerRepo.On("GetPlayerDuoMatches", mock.Anything, tt.filter).
+					Return(tt.duoMatches.Data, tt.duoMatches.Err).Once()
+			}
+
+			result, err := service.GetPlayerDuoPartners(context.Background(), tt.filter)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+
+			mockPlayerRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test the summary of the recent matches of a given player.
+func TestGetPlayerSummary(t *testing.T) {
+	service, mockPlayerRepo, _, mockMatchCache, _, _ := setupTestService()
+
+	entries := []playerrepo.MatchHistoryEntry{
+		{Id: 3, TeamPosition: "MIDDLE"},
+		{Id: 2, TeamPosition: "MIDDLE"},
+		{Id: 1, TeamPosition: "TOP"},
+	}
+	previews := []dto.MatchPreview{
+		getMockSummaryPreview(3, true, 103, 5, 1, 10, 15),
+		getMockSummaryPreview(2, true, 103, 2, 3, 4, 8),
+		getMockSummaryPreview(1, false, 84, 1, 0, 1, 4),
+	}
+
+	tests := []struct {
+		name          string
+		filter        *filters.PlayerSummaryFilter
+		playerInfo    *testutil.OperationRestult[*models.PlayerInfo]
+		entries       *testutil.OperationRestult[[]playerrepo.MatchHistoryEntry]
+		expected      *dto.RecentSummary
+		expectedError string
+	}{
+		{
+			name: "successful summary retrieval",
+			filter: &filters.PlayerSummaryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				Count:    20,
+			},
+			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			entries:    testutil.NewSuccessResult(entries),
+			expected: &dto.RecentSummary{
+				Matches:           3,
+				Wins:              2,
+				Losses:            1,
+				WinRate:           66.67,
+				AverageKills:      2.67,
+				AverageDeaths:     1.33,
+				AverageAssists:    5,
+				KDA:               5.75,
+				KillParticipation: 65.71,
+				Roles:             map[string]int{"MIDDLE": 2, "TOP": 1},
+				Champions: []*dto.RecentChampion{
+					{ChampionId: 103, Matches: 2, Wins: 2, WinRate: 100, KDA: 5.25},
+					{ChampionId: 84, Matches: 1, Wins: 0, WinRate: 0, KDA: 2},
+				},
+				Streak: 2,
+			},
+		},
+		{
+			name: "no recent matches",
+			filter: &filters.PlayerSummaryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				Count:    20,
+			},
+			playerInfo: testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			entries:    testutil.NewSuccessResult([]playerrepo.MatchHistoryEntry{}),
+			expected: &dto.RecentSummary{
+				Roles:     map[string]int{},
+				Champions: []*dto.RecentChampion{},
+			},
+		},
+		{
+			name: "player not found",
+			filter: &filters.PlayerSummaryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				Count:    20,
+			},
+			playerInfo:    testutil.NewErrorResult[*models.PlayerInfo](gorm.ErrRecordNotFound.Error()),
+			expectedError: fmt.Sprintf(messages.CouldNotFindId, "player"),
+		},
+		{
+			name: "recent matches error",
+			filter: &filters.PlayerSummaryFilter{
+				GameName: "TestPlayer",
+				GameTag:  "TAG1",
+				Region:   "NA1",
+				Count:    20,
+			},
+			playerInfo:    testutil.NewSuccessResult(&models.PlayerInfo{ID: 1}),
+			entries:       testutil.NewErrorResult[[]playerrepo.MatchHistoryEntry](gorm.ErrInvalidDB.Error()),
+			expectedError: "couldn't get the recent matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlayerRepo.On("GetPlayerByNameTagRegion", mock.Anything, tt.filter.GameName, tt.filter.GameTag, tt.filter.Region).
+				Return(tt.playerInfo.Data, tt.playerInfo.Err).Once()
+
+			if tt.entries != nil {
+				expectedFilter := &filters.PlayerMatchHistoryFilter{
+					GameName: tt.filter.GameName,
+					GameTag:  tt.filter.GameTag,
+					Region:   tt.filter.Region,
+					PlayerId: &tt.playerInfo.Data.ID,
+					PageSize: tt.filter.Count,
+				}
+				mockPlayerRepo.On("GetPlayerMatchHistoryEntries", mock.Anything, expectedFilter).
+					Return(tt.entries.Data, tt.entries.Err).Once()
+
+				if len(tt.entries.Data) > 0 {
+					mockMatchCache.On("GetMatchesPreviewByMatchIds", mock.Anything, []uint{3, 2, 1}).
+						Return(previews, []uint{}, nil).Once()
+				}
+			}
+
+			result, err := service.GetPlayerSummary(context.Background(), tt.filter)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+
+			mockPlayerRepo.AssertExpectations(t)
+			mockMatchCache.AssertExpectations(t)
+		})
+	}
+}
+
+// Test the comparison of two players.
+func TestGetPlayerComparison(t *testing.T) {
+	service, mockPlayerRepo, _, _, _, _ := setupTestService()
+
+	first := filters.PlayerURIParams{Region: "BR1", GameName: "First", GameTag: "BR1"}
+	second := filters.PlayerURIParams{Region: "BR1", GameName: "Second", GameTag: "BR1"}
+	filter := &filters.PlayerCompareFilter{
+		Players:  []filters.PlayerURIParams{first, second},
+		Interval: filters.DefaultStatsInterval,
+		Since:    time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	firstStats := []playerrepo.RawPlayerStatsStruct{
+		{PlayerId: 1, ChampionId: 103, TeamPosition: "ALL", QueueId: -1, AggregationLevel: "by_champion", Matches: 10},
+		{PlayerId: 1, ChampionId: 84, TeamPosition: "ALL", QueueId: -1, AggregationLevel: "by_champion", Matches: 5},
+	}
+	secondStats := []playerrepo.RawPlayerStatsStruct{
+		{PlayerId: 2, ChampionId: 103, TeamPosition: "ALL", QueueId: -1, AggregationLevel: "by_champion", Matches: 4},
+		{PlayerId: 2, ChampionId: 1, TeamPosition: "ALL", QueueId: -1, AggregationLevel: "by_champion", Matches: 8},
+	}
+
+	firstInfo := &models.PlayerInfo{ID: 1, RiotIdGameName: "First", RiotIdTagline: "BR1", Region: "BR1"}
+	secondInfo := &models.PlayerInfo{ID: 2, RiotIdGameName: "Second", RiotIdTagline: "BR1", Region: "BR1"}
+
+	tests := []struct {
+		name          string
+		players       []*models.PlayerInfo
+		shared        *testutil.OperationRestult[[]playerrepo.SharedMatchParticipant]
+		expectedError string
+	}{
+		{
+			name: "successful comparison",
+			// The players are returned out of the requested order.
+			players: []*models.PlayerInfo{secondInfo, firstInfo},
+			shared: testutil.NewSuccessResult([]playerrepo.SharedMatchParticipant{
+				// Won together.
+				{MatchId: 10, PlayerId: 1, TeamId: 100, Win: true},
+				{MatchId: 10, PlayerId: 2, TeamId: 100, Win: true},
+				// Lost against the second player.
+				{MatchId: 11, PlayerId: 1, TeamId: 100, Win: false},
+				{MatchId: 11, PlayerId: 2, TeamId: 200, Win: true},
+				// Won against the second player.
+				{MatchId: 12, PlayerId: 1, TeamId: 100, Win: true},
+				{MatchId: 12, PlayerId: 2, TeamId: 200, Win: false},
+			}),
+		},
+		{
+			name:          "second player not found",
+			players:       []*models.PlayerInfo{firstInfo},
+			expectedError: "Second#BR1: " + fmt.Sprintf(messages.CouldNotFindId, "player"),
+		},
+		{
+			name:          "shared matches error",
+			players:       []*models.PlayerInfo{firstInfo, secondInfo},
+			shared:        testutil.NewErrorResult[[]playerrepo.SharedMatchParticipant](gorm.ErrInvalidDB.Error()),
+			expectedError: "couldn't get the shared matches",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPlayerRepo.On("GetPlayersByNameTagRegion", mock.Anything, filter.Players).
+				Return(tt.players, nil).Once()
+
+			if len(tt.players) == len(filter.Players) {
+				mockPlayerRepo.On("GetPlayersRatingsByIds", mock.Anything, []uint{1, 2}).
+					Return([]models.RatingEntry{{PlayerId: 1, Queue: "RANKED_SOLO_5x5", Tier: "GOLD", Rank: "I"}}, nil).Once()
+				mockPlayerRepo.On("GetPlayersStats", mock.Anything, []uint{1, 2}, filter.Since, filter.Patch).
+					Return(append(firstStats, secondStats...), nil).Once()
+				mockPlayerRepo.On("GetSharedMatches", mock.Anything, []uint{1, 2}, filter.Since, filter.Patch).
+					Return(tt.shared.Data, tt.shared.Err).Once()
+			}
+
+			result, err := service.GetPlayerComparison(context.Background(), filter)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result.Players, 2)
+				assert.Equal(t, "First", result.Players[0].Name)
+				assert.Equal(t, "GOLD", result.Players[0].Rating[0].Tier)
+				assert.Equal(t, "Second", result.Players[1].Name)
+				assert.Empty(t, result.Players[1].Rating)
+
+				assert.Len(t, result.SharedChampions, 1)
+				assert.Equal(t, 103, result.SharedChampions[0].ChampionId)
+				assert.Equal(t, 10, result.SharedChampions[0].Stats[0].Matches)
+				assert.Equal(t, 4, result.SharedChampions[0].Stats[1].Matches)
+
+				assert.Equal(t, []*dto.PlayerEncounter{
+					{FirstPlayerId: 1, SecondPlayerId: 2, Together: 1, TogetherWins: 1, Against: 2, AgainstWins: 1},
+				}, result.Encounters)
+			}
+
+			mockPlayerRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package playerservice
+
+import (
+	"goleague/api/converters"
+	"goleague/api/dto"
+	playerrepo "goleague/api/repositories/player"
+	"sort"
+)
+
+// Champions returned on the recent matches summary.
+const recentChampionsLimit = 3
+
+// recentChampionCount is the sum of the stats of a champion on the recent matches.
+type recentChampionCount struct {
+	championId int
+	matches    int
+	wins       int
+	kills      int
+	deaths     int
+	assists    int
+}
+
+// summarizeRecentMatches summarizes the matches of the player, the entries must be ordered from the newest.
+// Matches without a preview are ignored.
+func summarizeRecentMatches(playerId uint, entries []playerrepo.MatchHistoryEntry, previews dto.MatchPreviewList) *dto.RecentSummary {
+	previewsById := make(map[uint]*dto.MatchPreview, len(previews))
+	for _, preview := range previews {
+		previewsById[preview.Metadata.InternalId] = preview
+	}
+
+	summary := &dto.RecentSummary{
+		Roles:     make(map[string]int),
+		Champions: []*dto.RecentChampion{},
+	}
+
+	champions := make(map[int]*recentChampionCount)
+	var kills, deaths, assists, teamKills int
+	streakOpen := true
+
+	for _, entry := range entries {
+		preview, exists := previewsById[entry.Id]
+		if !exists {
+			continue
+		}
+
+		player := findPreviewPlayer(preview, playerId)
+		if player == nil {
+			continue
+		}
+
+		for _, participant := range preview.Data {
+			if participant.TeamId == player.TeamId {
+				teamKills += participant.Kills
+			}
+		}
+
+		summary.Matches++
+		if player.Win {
+			summary.Wins++
+		} else {
+			summary.Losses++
+		}
+
+		kills += player.Kills
+		deaths += player.Deaths
+		assists += player.Assists
+
+		if entry.TeamPosition != "" {
+			summary.Roles[entry.TeamPosition]++
+		}
+
+		champion, exists := champions[player.ChampionID]
+		if !exists {
+			champion = &recentChampionCount{championId: player.ChampionID}
+			champions[player.ChampionID] = champion
+		}
+		champion.matches++
+		if player.Win {
+			champion.wins++
+		}
+		champion.kills += player.Kills
+		champion.deaths += player.Deaths
+		champion.assists += player.Assists
+
+		// The streak ends on the first match with the other result.
+		if streakOpen {
+			switch {
+			case player.Win && summary.Streak >= 0:
+				summary.Streak++
+			case !player.Win && summary.Streak <= 0:
+				summary.Streak--
+			default:
+				streakOpen = false
+			}
+		}
+	}
+
+	if summary.Matches == 0 {
+		return summary
+	}
+
+	matches := float64(summary.Matches)
+	summary.WinRate = converters.Percentage(summary.Wins, summary.Matches)
+	summary.AverageKills = converters.RoundTwoDecimals(float64(kills) / matches)
+	summary.AverageDeaths = converters.RoundTwoDecimals(float64(deaths) / matches)
+	summary.AverageAssists = converters.RoundTwoDecimals(float64(assists) / matches)
+	summary.KDA = kdaRatio(kills, deaths, assists)
+	if teamKills > 0 {
+		summary.KillParticipation = converters.Percentage(kills+assists, teamKills)
+	}
+
+	summary.Champions = rankRecentChampions(champions)
+
+	return summary
+}
+
+// findPreviewPlayer returns the data of the player on the match preview.
+func findPreviewPlayer(preview *dto.MatchPreview, playerId uint) *dto.MatchPreviewData {
+	for _, participant := range preview.Data {
+		if participant.PlayerId == playerId {
+			return participant
+		}
+	}
+	return nil
+}
+
+// rankRecentChampions returns the most played champions, the ties are broken by wins and then by champion.
+func rankRecentChampions(champions map[int]*recentChampionCount) []*dto.RecentChampion {
+	counts := make([]*recentChampionCount, 0, len(champions))
+	for _, champion := range champions {
+		counts = append(counts, champion)
+	}
+
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].matches != counts[j].matches {
+			return counts[i].matches > counts[j].matches
+		}
+		if counts[i].wins != counts[j].wins {
+			return counts[i].wins > counts[j].wins
+		}
+		return counts[i].championId < counts[j].championId
+	})
+
+	result := make([]*dto.RecentChampion, 0, min(len(counts), recentChampionsLimit))
+	for _, count := range counts[:min(len(counts), recentChampionsLimit)] {
+		result = append(result, &dto.RecentChampion{
+			ChampionId: count.championId,
+			Matches:    count.matches,
+			Wins:       count.wins,
+			WinRate:    converters.Percentage(count.wins, count.matches),
+			KDA:        kdaRatio(count.kills, count.deaths, count.assists),
+		})
+	}
+
+	return result
+}
+
+// kdaRatio returns the kills and assists by death, deathless matches count as a single death like on the stats.
+func kdaRatio(kills int, deaths int, assists int) float64 {
+	return converters.RoundTwoDecimals(float64(kills+assists) / float64(max(deaths, 1)))
+}
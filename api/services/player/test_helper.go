@@ -1,7 +1,11 @@
 package playerservice
 
 import (
+	"fmt"
+	"goleague/api/dto"
+	playerrepo "goleague/api/repositories/player"
 	"goleague/api/services/testutil"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -32,3 +36,29 @@ func setupTestService() (
 
 	return service, mockPlayerRepo, mockMatchRepo, mockMatchCache, mockPlayerGRPCClient, mockPlayerRedisClient
 }
+
+// Return a fixed start for each mocked match, the higher ids are the newest.
+func getMockHistoryStart(matchId uint) time.Time {
+	return time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(matchId) * time.Hour)
+}
+
+// Return mocked match history entries, in the given order.
+func getMockHistoryEntries(matchIds ...uint) []playerrepo.MatchHistoryEntry {
+	entries := make([]playerrepo.MatchHistoryEntry, len(matchIds))
+	for i, matchId := range matchIds {
+		entries[i] = playerrepo.MatchHistoryEntry{Id: matchId, MatchStart: getMockHistoryStart(matchId)}
+	}
+	return entries
+}
+
+// Return a mocked match preview, the player 1 is on the team 100 with a teammate against a single opponent.
+func getMockSummaryPreview(internalId uint, win bool, championId, kills, deaths, assists, teammateKills int) dto.MatchPreview {
+	return dto.MatchPreview{
+		Metadata: &dto.MatchPreviewMetadata{MatchId: fmt.Sprintf("match%d", internalId), InternalId: internalId},
+		Data: []*dto.MatchPreviewData{
+			{PlayerId: 1, TeamId: 100, Win: win, ChampionID: championId, Kills: kills, Deaths: deaths, Assists: assists},
+			{PlayerId: 2, TeamId: 100, Win: win, ChampionID: 1, Kills: teammateKills},
+			{PlayerId: 3, TeamId: 200, Win: !win, ChampionID: 2, Kills: 30},
+		},
+	}
+}
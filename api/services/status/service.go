@@ -0,0 +1,106 @@
+package statusservice
+
+import (
+	"context"
+	"goleague/api/dto"
+	statusrepo "goleague/api/repositories/status"
+	"goleague/pkg/regions"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// StatusService exposes the state of the background fetching.
+type StatusService struct {
+	db               *gorm.DB
+	StatusRepository statusrepo.StatusRepository
+}
+
+// StatusServiceDeps is the dependency list for the status service.
+type StatusServiceDeps struct {
+	DB *gorm.DB
+}
+
+// NewStatusService creates a status service.
+func NewStatusService(deps *StatusServiceDeps) *StatusService {
+	return &StatusService{
+		db:               deps.DB,
+		StatusRepository: statusrepo.NewStatusRepository(deps.DB),
+	}
+}
+
+// GetCrawlStatus returns the league crawl cursors grouped by sub region.
+func (ss *StatusService) GetCrawlStatus(ctx context.Context) ([]*dto.CrawlStatus, error) {
+	cursors, err := ss.StatusRepository.GetCrawlCursors(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles, err := ss.StatusRepository.GetCrawlCycles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statusByRegion := make(map[regions.SubRegion]*dto.CrawlStatus)
+	getStatus := func(region regions.SubRegion) *dto.CrawlStatus {
+		status, exists := statusByRegion[region]
+		if !exists {
+			status = &dto.CrawlStatus{
+				Cursors: []dto.CrawlCursor{},
+				Region:  string(region),
+			}
+			statusByRegion[region] = status
+		}
+		return status
+	}
+
+	for _, cursor := range cursors {
+		status := getStatus(cursor.Region)
+		status.Cursors = append(status.Cursors, dto.CrawlCursor{
+			CurrentPage: cursor.CurrentPage,
+			Queue:       cursor.Queue,
+			Rank:        cursor.Rank,
+			Tier:        cursor.Tier,
+			UpdatedAt:   cursor.UpdatedAt,
+		})
+	}
+
+	for _, cycle := range cycles {
+		completedAt := cycle.LastCompletedAt
+		getStatus(cycle.Region).LastCompletedCycle = &completedAt
+	}
+
+	result := make([]*dto.CrawlStatus, 0, len(statusByRegion))
+	for _, status := range statusByRegion {
+		result = append(result, status)
+	}
+
+	// Keep a stable order for the response.
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Region < result[j].Region
+	})
+
+	return result, nil
+}
+
+// GetFailureReport returns the reasons of the failed matches, most frequent first.
+func (ss *StatusService) GetFailureReport(ctx context.Context) ([]*dto.FailureReason, error) {
+	reasons, err := ss.StatusRepository.GetFailureReasons(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*dto.FailureReason, len(reasons))
+	for i, reason := range reasons {
+		result[i] = &dto.FailureReason{
+			Attempts:      reason.Attempts,
+			LastFailureAt: reason.LastFailureAt,
+			Permanent:     reason.Permanent,
+			Reason:        reason.Reason,
+			Retrying:      reason.Total - reason.Permanent,
+			Total:         reason.Total,
+		}
+	}
+
+	return result, nil
+}
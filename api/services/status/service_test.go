@@ -0,0 +1,173 @@
+package statusservice
+
+import (
+	"context"
+	"errors"
+	statusrepo "goleague/api/repositories/status"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"goleague/pkg/database/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// Simple test for asserting that everything is fine with the status service creation.
+func TestNewStatusService(t *testing.T) {
+	deps := &StatusServiceDeps{
+		DB: new(gorm.DB),
+	}
+
+	service := NewStatusService(deps)
+	assert.NotNil(t, service)
+	assert.Equal(t, new(gorm.DB), service.db)
+	assert.NotNil(t, service.StatusRepository)
+}
+
+func TestGetCrawlStatus(t *testing.T) {
+	tests := []struct {
+		name string
+
+		mockCursors *testutil.OperationRestult[[]models.LeagueCrawlCursor]
+		mockCycles  *testutil.OperationRestult[[]models.LeagueCrawlCycle]
+
+		expectedRegions []string
+		expectedError   error
+	}{
+		{
+			name:          "cursorsDbError",
+			mockCursors:   testutil.GetMockRepoError[[]models.LeagueCrawlCursor](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:          "cyclesDbError",
+			mockCursors:   testutil.NewSuccessResult(getMockCursors()),
+			mockCycles:    testutil.GetMockRepoError[[]models.LeagueCrawlCycle](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:            "noCrawlYet",
+			mockCursors:     testutil.NewSuccessResult([]models.LeagueCrawlCursor{}),
+			mockCycles:      testutil.NewSuccessResult([]models.LeagueCrawlCycle{}),
+			expectedRegions: []string{},
+		},
+		{
+			name:            "everythingFine",
+			mockCursors:     testutil.NewSuccessResult(getMockCursors()),
+			mockCycles:      testutil.NewSuccessResult(getMockCycles()),
+			expectedRegions: []string{"BR1", "NA1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockStatusRepo := setupTestService()
+
+			setupMocks(mockSetup{
+				repo:        mockStatusRepo,
+				mockCursors: tt.mockCursors,
+				mockCycles:  tt.mockCycles,
+			})
+
+			result, err := service.GetCrawlStatus(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, len(tt.expectedRegions))
+			for i, region := range tt.expectedRegions {
+				assert.Equal(t, region, result[i].Region)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockStatusRepo)
+		})
+	}
+}
+
+func TestGetCrawlStatusGrouping(t *testing.T) {
+	service, mockStatusRepo := setupTestService()
+
+	setupMocks(mockSetup{
+		repo:        mockStatusRepo,
+		mockCursors: testutil.NewSuccessResult(getMockCursors()),
+		mockCycles:  testutil.NewSuccessResult(getMockCycles()),
+	})
+
+	result, err := service.GetCrawlStatus(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+
+	// BR1 has two cursors and a completed cycle.
+	assert.Len(t, result[0].Cursors, 2)
+	assert.NotNil(t, result[0].LastCompletedCycle)
+	assert.Equal(t, getMockTime(), *result[0].LastCompletedCycle)
+	assert.Equal(t, 12, result[0].Cursors[0].CurrentPage)
+
+	// NA1 never completed a cycle.
+	assert.Len(t, result[1].Cursors, 1)
+	assert.Nil(t, result[1].LastCompletedCycle)
+
+	mockStatusRepo.AssertExpectations(t)
+}
+
+func TestGetFailureReport(t *testing.T) {
+	tests := []struct {
+		name string
+
+		mockFailures *testutil.OperationRestult[[]statusrepo.FailureReasonCount]
+
+		expectedReasons []string
+		expectedError   error
+	}{
+		{
+			name:          "dbError",
+			mockFailures:  testutil.GetMockRepoError[[]statusrepo.FailureReasonCount](),
+			expectedError: errors.New(testutil.DatabaseError),
+		},
+		{
+			name:            "noFailures",
+			mockFailures:    testutil.NewSuccessResult([]statusrepo.FailureReasonCount{}),
+			expectedReasons: []string{},
+		},
+		{
+			name:            "everythingFine",
+			mockFailures:    testutil.NewSuccessResult(getMockFailureReasons()),
+			expectedReasons: []string{models.FailureUntreatedQueue, models.FailureTimelineFetch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, mockStatusRepo := setupTestService()
+
+			setupMocks(mockSetup{
+				repo:         mockStatusRepo,
+				mockFailures: tt.mockFailures,
+			})
+
+			result, err := service.GetFailureReport(context.Background())
+
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError.Error())
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, result, len(tt.expectedReasons))
+			for i, reason := range tt.expectedReasons {
+				assert.Equal(t, reason, result[i].Reason)
+				assert.Equal(t, result[i].Total, result[i].Permanent+result[i].Retrying)
+			}
+
+			servicetestutil.VerifyAllMocks(t, mockStatusRepo)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package statusservice
+
+import (
+	statusrepo "goleague/api/repositories/status"
+	servicetestutil "goleague/api/services/testutil"
+	"goleague/internal/testutil"
+	"goleague/pkg/database/models"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+// Mock setup struct
+type mockSetup struct {
+	repo *servicetestutil.MockStatusRepository
+
+	mockCursors  *testutil.OperationRestult[[]models.LeagueCrawlCursor]
+	mockCycles   *testutil.OperationRestult[[]models.LeagueCrawlCycle]
+	mockFailures *testutil.OperationRestult[[]statusrepo.FailureReasonCount]
+}
+
+// Helper to initialize the mocks.
+func setupTestService() (*StatusService, *servicetestutil.MockStatusRepository) {
+	mockStatusRepo := new(servicetestutil.MockStatusRepository)
+
+	service := &StatusService{
+		db:               new(gorm.DB),
+		StatusRepository: mockStatusRepo,
+	}
+
+	return service, mockStatusRepo
+}
+
+func setupMocks(setup mockSetup) {
+	if setup.mockCursors != nil {
+		setup.repo.On("GetCrawlCursors", mock.Anything).Return(setup.mockCursors.Data, setup.mockCursors.Err)
+	}
+
+	if setup.mockCycles != nil {
+		setup.repo.On("GetCrawlCycles", mock.Anything).Return(setup.mockCycles.Data, setup.mockCycles.Err)
+	}
+
+	if setup.mockFailures != nil {
+		setup.repo.On("GetFailureReasons", mock.Anything).Return(setup.mockFailures.Data, setup.mockFailures.Err)
+	}
+}
+
+// Return a fixed time for the mocks.
+func getMockTime() time.Time {
+	return time.Date(2006, 1, 2, 15, 4, 5, 0, time.UTC)
+}
+
+// Return mocked cursors for two sub regions.
+func getMockCursors() []models.LeagueCrawlCursor {
+	return []models.LeagueCrawlCursor{
+		{Region: "NA1", Queue: "RANKED_SOLO_5x5", Tier: "DIAMOND", Rank: "I", CurrentPage: 3, UpdatedAt: getMockTime()},
+		{Region: "BR1", Queue: "RANKED_SOLO_5x5", Tier: "DIAMOND", Rank: "I", CurrentPage: 12, UpdatedAt: getMockTime()},
+		{Region: "BR1", Queue: "RANKED_SOLO_5x5", Tier: "EMERALD", Rank: "II", CurrentPage: 1, UpdatedAt: getMockTime()},
+	}
+}
+
+// Return a mocked completed cycle, only for one of the regions.
+func getMockCycles() []models.LeagueCrawlCycle {
+	return []models.LeagueCrawlCycle{
+		{Region: "BR1", LastCompletedAt: getMockTime()},
+	}
+}
+
+// Return mocked failure reasons, already sorted by total.
+func getMockFailureReasons() []statusrepo.FailureReasonCount {
+	return []statusrepo.FailureReasonCount{
+		{Reason: models.FailureUntreatedQueue, Total: 10, Permanent: 10, Attempts: 10, LastFailureAt: getMockTime()},
+		{Reason: models.FailureTimelineFetch, Total: 4, Permanent: 1, Attempts: 12, LastFailureAt: getMockTime()},
+	}
+}
@@ -4,11 +4,17 @@ import (
 	"context"
 	"goleague/api/dto"
 	"goleague/api/filters"
+	championrepo "goleague/api/repositories/champion"
+	leaderboardrepo "goleague/api/repositories/leaderboard"
 	matchrepo "goleague/api/repositories/match"
+	patchrepo "goleague/api/repositories/patch"
 	playerrepo "goleague/api/repositories/player"
+	statusrepo "goleague/api/repositories/status"
 	tierlistrepo "goleague/api/repositories/tierlist"
 	"goleague/pkg/database/models"
 	pb "goleague/pkg/grpc"
+	"goleague/pkg/models/champion"
+	"goleague/pkg/models/item"
 	"testing"
 	"time"
 
@@ -46,9 +52,9 @@ func (m *MockPlayerRepository) GetPlayerByNameTagRegion(ctx context.Context, nam
 	return args.Get(0).(*models.PlayerInfo), args.Error(1)
 }
 
-func (m *MockPlayerRepository) GetPlayerMatchHistoryIds(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]uint, error) {
+func (m *MockPlayerRepository) GetPlayerMatchHistoryEntries(ctx context.Context, filters *filters.PlayerMatchHistoryFilter) ([]playerrepo.MatchHistoryEntry, error) {
 	args := m.Called(ctx, filters)
-	return args.Get(0).([]uint), args.Error(1)
+	return args.Get(0).([]playerrepo.MatchHistoryEntry), args.Error(1)
 }
 
 func (m *MockPlayerRepository) GetPlayerById(ctx context.Context, id uint) (*models.PlayerInfo, error) {
@@ -56,6 +62,21 @@ func (m *MockPlayerRepository) GetPlayerById(ctx context.Context, id uint) (*mod
 	return args.Get(0).(*models.PlayerInfo), args.Error(1)
 }
 
+func (m *MockPlayerRepository) GetPlayerRatingHistory(ctx context.Context, filters *filters.PlayerRatingHistoryFilter) ([]models.RatingEntry, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]models.RatingEntry), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayerDuoMatches(ctx context.Context, filters *filters.PlayerDuoFilter) ([]playerrepo.DuoMatch, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]playerrepo.DuoMatch), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayerRatingPeaks(ctx context.Context, playerId uint, queue string) ([]playerrepo.RatingPeak, error) {
+	args := m.Called(ctx, playerId, queue)
+	return args.Get(0).([]playerrepo.RatingPeak), args.Error(1)
+}
+
 func (m *MockPlayerRepository) GetPlayerRatingsById(ctx context.Context, id uint) ([]models.RatingEntry, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).([]models.RatingEntry), args.Error(1)
@@ -66,6 +87,26 @@ func (m *MockPlayerRepository) GetPlayerStats(ctx context.Context, filters *filt
 	return args.Get(0).([]playerrepo.RawPlayerStatsStruct), args.Error(1)
 }
 
+func (m *MockPlayerRepository) GetPlayersByNameTagRegion(ctx context.Context, players []filters.PlayerURIParams) ([]*models.PlayerInfo, error) {
+	args := m.Called(ctx, players)
+	return args.Get(0).([]*models.PlayerInfo), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayersRatingsByIds(ctx context.Context, playerIds []uint) ([]models.RatingEntry, error) {
+	args := m.Called(ctx, playerIds)
+	return args.Get(0).([]models.RatingEntry), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetPlayersStats(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]playerrepo.RawPlayerStatsStruct, error) {
+	args := m.Called(ctx, playerIds, since, patch)
+	return args.Get(0).([]playerrepo.RawPlayerStatsStruct), args.Error(1)
+}
+
+func (m *MockPlayerRepository) GetSharedMatches(ctx context.Context, playerIds []uint, since time.Time, patch string) ([]playerrepo.SharedMatchParticipant, error) {
+	args := m.Called(ctx, playerIds, since, patch)
+	return args.Get(0).([]playerrepo.SharedMatchParticipant), args.Error(1)
+}
+
 // Match mock implementations.
 type MockMatchRepository struct {
 	mock.Mock
@@ -96,6 +137,11 @@ func (m *MockMatchRepository) GetParticipantFramesByInternalId(ctx context.Conte
 	return args.Get(0).([]matchrepo.RawMatchParticipantFrame), args.Error(1)
 }
 
+func (m *MockMatchRepository) HasPendingTimeline(ctx context.Context, matchID uint) (bool, error) {
+	args := m.Called(ctx, matchID)
+	return args.Bool(0), args.Error(1)
+}
+
 // Cache mock implementations.
 type MockMatchCache struct {
 	mock.Mock
@@ -126,6 +172,15 @@ func (m *MockPlayerGRPCClient) ForceFetchPlayerMatchHistory(ctx context.Context,
 	return args.Get(0).(*pb.MatchHistoryFetchNotification), args.Error(1)
 }
 
+type MockMatchGRPCClient struct {
+	mock.Mock
+}
+
+func (m *MockMatchGRPCClient) FetchMatchTimeline(ctx context.Context, matchId string) (*pb.MatchTimelineNotification, error) {
+	args := m.Called(ctx, matchId)
+	return args.Get(0).(*pb.MatchTimelineNotification), args.Error(1)
+}
+
 // Player redis client mock implementation.
 type MockPlayerRedisClient struct {
 	mock.Mock
@@ -141,6 +196,16 @@ func (m *MockPlayerRedisClient) TTL(ctx context.Context, key string) *redis.Dura
 	return args.Get(0).(*redis.DurationCmd)
 }
 
+// Match redis client mock implementation.
+type MockMatchRedisClient struct {
+	mock.Mock
+}
+
+func (m *MockMatchRedisClient) SetNX(ctx context.Context, key string, value any, expiration time.Duration) *redis.BoolCmd {
+	args := m.Called(ctx, key, value, expiration)
+	return args.Get(0).(*redis.BoolCmd)
+}
+
 // ============================================================================
 // Mock Implementations used in the Tierlist service tests.
 // ============================================================================
@@ -160,9 +225,14 @@ type MockChampionCache struct {
 	mock.Mock
 }
 
-func (m *MockChampionCache) GetChampionCopy(ctx context.Context, championId string) (map[string]any, error) {
+func (m *MockChampionCache) GetAllChampions(ctx context.Context) ([]*champion.Champion, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*champion.Champion), args.Error(1)
+}
+
+func (m *MockChampionCache) GetChampionCopy(ctx context.Context, championId string) (*champion.Champion, error) {
 	args := m.Called(ctx, championId)
-	return args.Get(0).(map[string]any), args.Error(1)
+	return args.Get(0).(*champion.Champion), args.Error(1)
 }
 
 func (m *MockChampionCache) Initialize(ctx context.Context) error {
@@ -211,3 +281,119 @@ func (m *MockTierlistRedisClient) Set(ctx context.Context, key string, value any
 	args := m.Called(ctx, key, value, ttl)
 	return args.Error(0)
 }
+
+// ============================================================================
+// Mock Implementations used in the Status service tests.
+// ============================================================================
+
+// Status Repo mock implementation.
+type MockStatusRepository struct {
+	mock.Mock
+}
+
+func (m *MockStatusRepository) GetCrawlCursors(ctx context.Context) ([]models.LeagueCrawlCursor, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.LeagueCrawlCursor), args.Error(1)
+}
+
+func (m *MockStatusRepository) GetCrawlCycles(ctx context.Context) ([]models.LeagueCrawlCycle, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]models.LeagueCrawlCycle), args.Error(1)
+}
+
+func (m *MockStatusRepository) GetFailureReasons(ctx context.Context) ([]statusrepo.FailureReasonCount, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]statusrepo.FailureReasonCount), args.Error(1)
+}
+
+// ============================================================================
+// Mock Implementations used in the Patch service tests.
+// ============================================================================
+
+// Patch Repo mock implementation.
+type MockPatchRepository struct {
+	mock.Mock
+}
+
+func (m *MockPatchRepository) GetPatches(ctx context.Context) ([]patchrepo.PatchMatchCount, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]patchrepo.PatchMatchCount), args.Error(1)
+}
+
+// ============================================================================
+// Mock Implementations used in the Leaderboard service tests.
+// ============================================================================
+
+// Leaderboard Repo mock implementation.
+type MockLeaderboardRepository struct {
+	mock.Mock
+}
+
+func (m *MockLeaderboardRepository) GetLeaderboard(ctx context.Context, filters *filters.LeaderboardFilter) ([]*leaderboardrepo.LeaderboardEntry, error) {
+	args := m.Called(ctx, filters)
+	return args.Get(0).([]*leaderboardrepo.LeaderboardEntry), args.Error(1)
+}
+
+func (m *MockLeaderboardRepository) GetMostPlayedChampions(ctx context.Context, playerIds []uint, queueId int, since time.Time, limit int) ([]*leaderboardrepo.PlayerChampionCount, error) {
+	args := m.Called(ctx, playerIds, queueId, since, limit)
+	return args.Get(0).([]*leaderboardrepo.PlayerChampionCount), args.Error(1)
+}
+
+// ============================================================================
+// Mock Implementations used in the Champion service tests.
+// ============================================================================
+
+// Champion redis client mock implementation.
+type MockChampionRedisClient struct {
+	mock.Mock
+}
+
+func (m *MockChampionRedisClient) Get(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(string), args.Error(1)
+}
+
+func (m *MockChampionRedisClient) Set(ctx context.Context, key string, value any, ttl time.Duration) error {
+	args := m.Called(ctx, key, value, ttl)
+	return args.Error(0)
+}
+
+// Champion Repo mock implementation.
+type MockChampionRepository struct {
+	mock.Mock
+}
+
+func (m *MockChampionRepository) GetChampionStats(ctx context.Context, championKey int, filters *filters.GetChampionDataFilter) ([]*championrepo.ChampionRoleStats, error) {
+	args := m.Called(ctx, championKey, filters)
+	return args.Get(0).([]*championrepo.ChampionRoleStats), args.Error(1)
+}
+
+func (m *MockChampionRepository) GetChampionItemEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*championrepo.ChampionItemEvent, error) {
+	args := m.Called(ctx, championKey, filters)
+	return args.Get(0).([]*championrepo.ChampionItemEvent), args.Error(1)
+}
+
+func (m *MockChampionRepository) GetChampionSkillEvents(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*championrepo.ChampionSkillEvent, error) {
+	args := m.Called(ctx, championKey, filters)
+	return args.Get(0).([]*championrepo.ChampionSkillEvent), args.Error(1)
+}
+
+func (m *MockChampionRepository) GetChampionMatchups(ctx context.Context, championKey int, filters *filters.ChampionStatsFilter) ([]*championrepo.ChampionMatchupStats, error) {
+	args := m.Called(ctx, championKey, filters)
+	return args.Get(0).([]*championrepo.ChampionMatchupStats), args.Error(1)
+}
+
+func (m *MockChampionRepository) GetChampionSynergies(ctx context.Context, championKey int, filters *filters.TierlistFilter) ([]*championrepo.ChampionSynergyStats, error) {
+	args := m.Called(ctx, championKey, filters)
+	return args.Get(0).([]*championrepo.ChampionSynergyStats), args.Error(1)
+}
+
+// ItemCache mock implementation.
+type MockItemCache struct {
+	mock.Mock
+}
+
+func (m *MockItemCache) GetAllItems(ctx context.Context) (map[string]*item.Item, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(map[string]*item.Item), args.Error(1)
+}
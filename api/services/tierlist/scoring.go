@@ -0,0 +1,160 @@
+package tierlistservice
+
+import (
+	"goleague/api/converters"
+	"goleague/api/dto"
+	"math"
+	"sort"
+)
+
+const (
+	// Games at the role average added to every champion, pulling the small samples towards it.
+	tierlistPriorGames = 50.0
+	// Z value of the 95% Wilson confidence interval.
+	tierlistWilsonZ = 1.96
+	// Score added by each percent of pick and ban rate, a contested champion is stronger than its win rate shows.
+	tierlistPickRateWeight = 0.1
+	tierlistBanRateWeight  = 0.05
+)
+
+// Grades by the minimum standard deviations of the score above the role mean.
+var tierlistGrades = []struct {
+	grade        string
+	minDeviation float64
+}{
+	{"S+", 1.5},
+	{"S", 1.0},
+	{"A", 0.35},
+	{"B", -0.35},
+	{"C", -1.0},
+}
+
+// Grade of the scores below every threshold.
+const tierlistLowestGrade = "D"
+
+// Grades that require the win rate to be above the role average with confidence.
+var tierlistConfidentGrades = map[string]bool{"S+": true, "S": true}
+
+// roleStats aggregates the entries of a team position.
+type roleStats struct {
+	picks     int
+	wins      int
+	scores    []float64
+	winRate   float64
+	meanScore float64
+	stdScore  float64
+}
+
+// rankTierlist scores and grades each champion and role, sorting by the score.
+// The win rate is smoothed towards the role average, so a few lucky games don't top the list.
+func rankTierlist(results []*dto.TierlistResult) {
+	roles := make(map[string]*roleStats)
+	for _, result := range results {
+		role, exists := roles[result.TeamPosition]
+		if !exists {
+			role = &roleStats{}
+			roles[result.TeamPosition] = role
+		}
+		role.picks += result.PickCount
+		role.wins += result.Wins
+	}
+
+	for _, role := range roles {
+		if role.picks > 0 {
+			role.winRate = float64(role.wins) / float64(role.picks)
+		}
+	}
+
+	for _, result := range results {
+		role := roles[result.TeamPosition]
+
+		adjusted := (float64(result.Wins) + tierlistPriorGames*role.winRate) / (float64(result.PickCount) + tierlistPriorGames)
+		lower, upper := wilsonInterval(result.Wins, result.PickCount)
+
+		result.AdjustedWinRate = converters.RoundTwoDecimals(adjusted * 100)
+		result.WinRateLower = converters.RoundTwoDecimals(lower * 100)
+		result.WinRateUpper = converters.RoundTwoDecimals(upper * 100)
+		result.Score = converters.RoundTwoDecimals((adjusted-role.winRate)*100 +
+			result.PickRate*tierlistPickRateWeight +
+			result.BanRate*tierlistBanRateWeight)
+
+		role.scores = append(role.scores, result.Score)
+	}
+
+	for _, role := range roles {
+		role.meanScore, role.stdScore = meanAndDeviation(role.scores)
+	}
+
+	for _, result := range results {
+		role := roles[result.TeamPosition]
+		result.Grade = scoreGrade(result.Score, role, result.WinRateLower > role.winRate*100)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].PickCount > results[j].PickCount
+	})
+}
+
+// scoreGrade returns the grade of a score, relative to the other champions of the role.
+// Without confidence that the champion wins more than the role average, it stays below the S grades.
+func scoreGrade(score float64, role *roleStats, confident bool) string {
+	// Every champion of the role has the same score.
+	if role.stdScore == 0 {
+		return "B"
+	}
+
+	deviation := (score - role.meanScore) / role.stdScore
+	for _, grade := range tierlistGrades {
+		if deviation < grade.minDeviation {
+			continue
+		}
+
+		if tierlistConfidentGrades[grade.grade] && !confident {
+			return "A"
+		}
+
+		return grade.grade
+	}
+
+	return tierlistLowestGrade
+}
+
+// wilsonInterval returns the 95% confidence interval of the win rate.
+func wilsonInterval(wins int, games int) (float64, float64) {
+	if games == 0 {
+		return 0, 0
+	}
+
+	n := float64(games)
+	p := float64(wins) / n
+	z2 := tierlistWilsonZ * tierlistWilsonZ
+
+	denominator := 1 + z2/n
+	center := (p + z2/(2*n)) / denominator
+	margin := tierlistWilsonZ * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / denominator
+
+	return math.Max(0, center-margin), math.Min(1, center+margin)
+}
+
+// meanAndDeviation returns the mean and the population standard deviation.
+func meanAndDeviation(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
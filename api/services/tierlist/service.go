@@ -7,6 +7,7 @@ import (
 	"goleague/api/dto"
 	"goleague/api/filters"
 	tierlistrepo "goleague/api/repositories/tierlist"
+	"goleague/pkg/metrics"
 	"strconv"
 	"strings"
 	"time"
@@ -18,6 +19,7 @@ const (
 	TierlistMemoryCacheDuration = 15 * time.Minute
 	TierlistRedisCacheDuration  = time.Hour
 	TierlistRedisCacheTimeout   = time.Millisecond * 200
+	tierlistCacheName           = "tierlist"
 )
 
 type TierlistRedisClient interface {
@@ -55,17 +57,22 @@ func (ts *TierlistService) GetTierlist(ctx context.Context, filters *filters.Tie
 	key := ts.getTierlistKey(filters)
 
 	if mem := ts.getFromMemCache(key); mem != nil {
+		metrics.CacheHit(tierlistCacheName, metrics.CacheLayerMemory)
 		return mem, nil
 	}
+	metrics.CacheMiss(tierlistCacheName, metrics.CacheLayerMemory)
 
 	if redisData := ts.getFromRedis(key); redisData != nil {
+		metrics.CacheHit(tierlistCacheName, metrics.CacheLayerRedis)
 		ts.memCache.Set(key, redisData, TierlistMemoryCacheDuration)
 		return redisData, nil
 	}
+	metrics.CacheMiss(tierlistCacheName, metrics.CacheLayerRedis)
 
 	// Get the data from the repository.
 	results, err := ts.TierlistRepository.GetTierlist(ctx, filters)
 	if err != nil {
+		metrics.QueryError(tierlistCacheName)
 		return nil, err
 	}
 
@@ -75,6 +82,7 @@ func (ts *TierlistService) GetTierlist(ctx context.Context, filters *filters.Tie
 
 	var dtoHelper dto.TierlistResult
 	tierlistResultDTO := dtoHelper.FromRepositorySlice(results)
+	rankTierlist(tierlistResultDTO)
 
 	ts.populateCaches(key, tierlistResultDTO)
 
@@ -125,6 +133,37 @@ func (ts *TierlistService) getTierlistKey(filters *filters.TierlistFilter) strin
 		builder.WriteString(":with_higher_tiers")
 	}
 
+	if filters.Patch != "" {
+		builder.WriteString(":patch_" + filters.Patch)
+	}
+
+	if filters.Region != "" {
+		builder.WriteString(":region_" + filters.Region)
+	}
+
+	if filters.Role != "" {
+		builder.WriteString(":role_" + filters.Role)
+	}
+
+	if filters.MinGames != 0 {
+		builder.WriteString(":min_games_" + strconv.Itoa(filters.MinGames))
+	}
+
+	// A window of days moves with the time, so the key has the days and not the dates.
+	if filters.Days != 0 {
+		builder.WriteString(":days_" + strconv.Itoa(filters.Days))
+	}
+
+	if filters.CustomPeriod {
+		if !filters.Since.IsZero() {
+			builder.WriteString(":since_" + filters.Since.Format(time.DateOnly))
+		}
+
+		if !filters.Until.IsZero() {
+			builder.WriteString(":until_" + filters.Until.Format(time.DateOnly))
+		}
+	}
+
 	return builder.String()
 }
 
@@ -9,6 +9,7 @@ import (
 	servicetestutil "goleague/api/services/testutil"
 	"goleague/internal/testutil"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -155,6 +156,47 @@ func TestGetTierlistKey(t *testing.T) {
 			filters:  &filters.TierlistFilter{GetTiersAbove: true},
 			expected: "tierlist:with_higher_tiers",
 		},
+		{
+			name:     "With patch",
+			filters:  &filters.TierlistFilter{Patch: "15.19"},
+			expected: "tierlist:patch_15.19",
+		},
+		{
+			name:     "With region, role and minimum games",
+			filters:  &filters.TierlistFilter{Region: "BR1", Role: "UTILITY", MinGames: 50},
+			expected: "tierlist:region_BR1:role_UTILITY:min_games_50",
+		},
+		{
+			name:     "Without a period",
+			filters:  filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420}),
+			expected: "tierlist:queue_420:days_30",
+		},
+		{
+			name:     "With a patch and without a period",
+			filters:  filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420, Patch: "15.19"}),
+			expected: "tierlist:queue_420:patch_15.19",
+		},
+		{
+			name:     "With a window of days",
+			filters:  filters.NewTierlistFilter(filters.TierlistQueryParams{Queue: 420, Days: 30}),
+			expected: "tierlist:queue_420:days_30",
+		},
+		{
+			name: "With a window of days ending on a date",
+			filters: filters.NewTierlistFilter(filters.TierlistQueryParams{
+				Days: 7,
+				To:   time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: "tierlist:days_7:since_2025-10-09:until_2025-10-16",
+		},
+		{
+			name: "With custom period",
+			filters: filters.NewTierlistFilter(filters.TierlistQueryParams{
+				From: time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+				To:   time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC),
+			}),
+			expected: "tierlist:since_2025-10-01:until_2025-10-16",
+		},
 		{
 			name: "With all parameters",
 			filters: &filters.TierlistFilter{
@@ -173,3 +215,82 @@ func TestGetTierlistKey(t *testing.T) {
 		})
 	}
 }
+
+// Assert that the ranking doesn't favor small samples and grades relative to the role.
+func TestRankTierlist(t *testing.T) {
+	tests := []struct {
+		name           string
+		results        []*dto.TierlistResult
+		expectedOrder  []int
+		expectedGrades map[int]string
+	}{
+		{
+			name:    "empty",
+			results: []*dto.TierlistResult{},
+		},
+		{
+			name: "singleChampion",
+			results: []*dto.TierlistResult{
+				{ChampionId: 1, TeamPosition: "TOP", PickCount: 10, Wins: 5, WinRate: 50},
+			},
+			expectedOrder:  []int{1},
+			expectedGrades: map[int]string{1: "B"},
+		},
+		{
+			name: "smallSampleDoesntTop",
+			results: []*dto.TierlistResult{
+				{ChampionId: 1, TeamPosition: "BOTTOM", PickCount: 12, Wins: 8, WinRate: 66.67},
+				{ChampionId: 2, TeamPosition: "BOTTOM", PickCount: 800, Wins: 440, WinRate: 55},
+				{ChampionId: 3, TeamPosition: "BOTTOM", PickCount: 1000, Wins: 480, WinRate: 48},
+				{ChampionId: 4, TeamPosition: "BOTTOM", PickCount: 900, Wins: 423, WinRate: 47},
+			},
+			expectedOrder:  []int{2, 1, 3, 4},
+			expectedGrades: map[int]string{2: "S", 1: "A", 3: "C", 4: "D"},
+		},
+		{
+			// The few lucky games of the last champion are pulled towards the role average, close to the banned champion.
+			// Neither of them wins more than the role with confidence, so both stay below the S grades.
+			name: "unconfidentCapped",
+			results: []*dto.TierlistResult{
+				{ChampionId: 1, TeamPosition: "MIDDLE", PickCount: 500, Wins: 250, WinRate: 50, BanRate: 60},
+				{ChampionId: 2, TeamPosition: "MIDDLE", PickCount: 500, Wins: 255, WinRate: 51},
+				{ChampionId: 3, TeamPosition: "MIDDLE", PickCount: 500, Wins: 250, WinRate: 50},
+				{ChampionId: 4, TeamPosition: "MIDDLE", PickCount: 500, Wins: 245, WinRate: 49},
+				{ChampionId: 5, TeamPosition: "MIDDLE", PickCount: 500, Wins: 240, WinRate: 48},
+				{ChampionId: 6, TeamPosition: "MIDDLE", PickCount: 10, Wins: 7, WinRate: 70},
+			},
+			expectedOrder:  []int{6, 1, 2, 3, 4, 5},
+			expectedGrades: map[int]string{6: "A", 1: "A", 2: "B", 3: "C", 4: "C", 5: "D"},
+		},
+		{
+			name: "confidentTop",
+			results: []*dto.TierlistResult{
+				{ChampionId: 1, TeamPosition: "MIDDLE", PickCount: 40, Wins: 30, WinRate: 75},
+				{ChampionId: 2, TeamPosition: "MIDDLE", PickCount: 40, Wins: 20, WinRate: 50},
+				{ChampionId: 3, TeamPosition: "MIDDLE", PickCount: 40, Wins: 19, WinRate: 47.5},
+				{ChampionId: 4, TeamPosition: "MIDDLE", PickCount: 40, Wins: 18, WinRate: 45},
+				{ChampionId: 5, TeamPosition: "MIDDLE", PickCount: 40, Wins: 17, WinRate: 42.5},
+			},
+			expectedOrder:  []int{1, 2, 3, 4, 5},
+			expectedGrades: map[int]string{1: "S+", 2: "B", 3: "C", 4: "C", 5: "C"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rankTierlist(tt.results)
+
+			order := make([]int, len(tt.results))
+			for i, result := range tt.results {
+				order[i] = result.ChampionId
+				assert.Equal(t, tt.expectedGrades[result.ChampionId], result.Grade)
+				assert.LessOrEqual(t, result.WinRateLower, result.WinRate)
+				assert.GreaterOrEqual(t, result.WinRateUpper, result.WinRate)
+			}
+
+			if tt.expectedOrder != nil {
+				assert.Equal(t, tt.expectedOrder, order)
+			}
+		})
+	}
+}
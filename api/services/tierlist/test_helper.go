@@ -50,22 +50,34 @@ func setupTestService() (*TierlistService, *servicetestutil.MockTierlistReposito
 func createExpectedSuccessFullTierlist() []*dto.TierlistResult {
 	return []*dto.TierlistResult{
 		{
-			BanCount:     10,
-			BanRate:      0.15,
-			PickCount:    50,
-			PickRate:     0.25,
-			TeamPosition: "ADC",
-			WinRate:      0.52,
-			ChampionId:   1,
+			AdjustedWinRate: 52,
+			BanCount:        10,
+			BanRate:         0.15,
+			PickCount:       50,
+			PickRate:        0.25,
+			TeamPosition:    "ADC",
+			WinRate:         52,
+			WinRateLower:    38.51,
+			WinRateUpper:    65.2,
+			Wins:            26,
+			Grade:           "B",
+			Score:           0.03,
+			ChampionId:      1,
 		},
 		{
-			BanCount:     5,
-			BanRate:      0.08,
-			PickCount:    30,
-			PickRate:     0.18,
-			TeamPosition: "MID",
-			WinRate:      0.48,
-			ChampionId:   2,
+			AdjustedWinRate: 46.67,
+			BanCount:        5,
+			BanRate:         0.08,
+			PickCount:       30,
+			PickRate:        0.18,
+			TeamPosition:    "MID",
+			WinRate:         46.67,
+			WinRateLower:    30.23,
+			WinRateUpper:    63.86,
+			Wins:            14,
+			Grade:           "B",
+			Score:           0.02,
+			ChampionId:      2,
 		},
 	}
 }
@@ -79,7 +91,8 @@ func createSuccessRepoTierlist() []*tierlistrepo.TierlistResult {
 			PickCount:    50,
 			PickRate:     0.25,
 			TeamPosition: "ADC",
-			WinRate:      0.52,
+			WinRate:      52,
+			Wins:         26,
 			ChampionId:   1,
 		},
 		{
@@ -88,7 +101,8 @@ func createSuccessRepoTierlist() []*tierlistrepo.TierlistResult {
 			PickCount:    30,
 			PickRate:     0.18,
 			TeamPosition: "MID",
-			WinRate:      0.48,
+			WinRate:      46.67,
+			Wins:         14,
 			ChampionId:   2,
 		},
 	}
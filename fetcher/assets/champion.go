@@ -146,7 +146,7 @@ func RevalidateSingleChampionByKey(redis *redis.RedisClient, language string, ch
 	keyWithId := fmt.Sprint(championPrefix, champ.ID)
 
 	if repo != nil {
-		repo.Setkey(keyWithId, string(champJson))
+		repo.Setkey(ctx, keyWithId, string(champJson))
 	}
 
 	// Get the client and set the champion.
@@ -64,6 +64,9 @@ func handleItemEntry(redis *redis.RedisClient, repo repositories.CacheRepository
 		Name:        getStringOrDefault(itemData, "name"),
 		Description: getStringOrDefault(itemData, "description"),
 		Plaintext:   getStringOrDefault(itemData, "plaintext"),
+		From:        getStringSliceOrDefault(itemData, "from"),
+		Into:        getStringSliceOrDefault(itemData, "into"),
+		Tags:        getStringSliceOrDefault(itemData, "tags"),
 	}
 
 	// Get the image data.
@@ -86,7 +89,7 @@ func handleItemEntry(redis *redis.RedisClient, repo repositories.CacheRepository
 
 	// Set the key on the database. Fallback.
 	if repo != nil {
-		repo.Setkey(keyWithId, string(itemJson))
+		repo.Setkey(ctx, keyWithId, string(itemJson))
 	}
 
 	// Get the client and set the champion.
@@ -56,3 +56,19 @@ func getStringOrDefault(data map[string]any, key string) string {
 	}
 	return ""
 }
+
+// Return the strings of a list if it's available, else returns nil.
+func getStringSliceOrDefault(data map[string]any, key string) []string {
+	values, ok := data[key].([]any)
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if val, ok := value.(string); ok {
+			result = append(result, val)
+		}
+	}
+	return result
+}
@@ -43,10 +43,9 @@ func NewSubLeagueFetcher(apiKey string, limiter *gomultirate.RateLimiter, region
 
 // GetLeagueEntries gets all entries of a given league page.
 // Used only for  job  requests, since it would not be necessary to get a given page at demand.
-func (l *SubLeagueFetcher) GetLeagueEntries(tier string, rank string, queue string, page int) ([]LeagueEntry, error) {
+func (l *SubLeagueFetcher) GetLeagueEntries(ctx context.Context, tier string, rank string, queue string, page int) ([]LeagueEntry, error) {
 	// Wait for job.
-	ctx := context.Background()
-	l.limiter.WaitEvenly(ctx, "job")
+	requests.WaitLimiter(ctx, l.limiter, l.region, false)
 
 	// Format the URL and create the params.
 	// Riot only accept upper case on this entries.
@@ -54,22 +53,17 @@ func (l *SubLeagueFetcher) GetLeagueEntries(tier string, rank string, queue stri
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/league-exp/v4/entries/%s/%s/%s",
 		l.region, queue, strings.ToUpper(tier), strings.ToUpper(rank))
 
-	return requests.HandleAuthRequest[[]LeagueEntry](l.apiKey, url, "GET", map[string]string{"page": fmt.Sprintf("%d", page)})
+	return requests.HandleAuthRequest[[]LeagueEntry](ctx, l.apiKey, requests.EndpointLeagueEntries, url, "GET", map[string]string{"page": fmt.Sprintf("%d", page)})
 }
 
 // GetLeagueEntryByPuuid fetches all queues entries for a given PUUID.
-func (l *SubLeagueFetcher) GetLeagueEntriesByPuuid(puuid string, onDemand bool) ([]LeagueEntry, error) {
+func (l *SubLeagueFetcher) GetLeagueEntriesByPuuid(ctx context.Context, puuid string, onDemand bool) ([]LeagueEntry, error) {
 	// Wait for job.
-	ctx := context.Background()
-	if onDemand {
-		l.limiter.Wait(ctx)
-	} else {
-		l.limiter.WaitEvenly(ctx, "job")
-	}
+	requests.WaitLimiter(ctx, l.limiter, l.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/league/v4/entries/by-puuid/%s",
 		l.region, puuid)
 
-	return requests.HandleAuthRequest[[]LeagueEntry](l.apiKey, url, "GET", map[string]string{})
+	return requests.HandleAuthRequest[[]LeagueEntry](ctx, l.apiKey, requests.EndpointLeagueEntriesPuuid, url, "GET", map[string]string{})
 }
@@ -68,32 +68,21 @@ type MatchData struct {
 }
 
 // GetMatchData returns a given match data.
-func (m *MatchFetcher) GetMatchData(matchId string, onDemand bool) (*MatchData, error) {
-	ctx := context.Background()
-	// Verify if it's onDemand.
-	if onDemand {
-		m.limiter.Wait(ctx)
-	} else {
-		m.limiter.WaitEvenly(ctx, "job")
-	}
+func (m *MatchFetcher) GetMatchData(ctx context.Context, matchId string, onDemand bool) (*MatchData, error) {
+	requests.WaitLimiter(ctx, m.limiter, m.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/match/v5/matches/%s", m.region, matchId)
 
-	return requests.HandleAuthRequest[*MatchData](m.apiKey, url, "GET", map[string]string{})
+	return requests.HandleAuthRequest[*MatchData](ctx, m.apiKey, requests.EndpointMatch, url, "GET", map[string]string{})
 }
 
 // GetMatchTimelineData returns a given match timeline.
-func (m *MatchFetcher) GetMatchTimelineData(matchId string, onDemand bool) (*MatchTimeline, error) {
-	ctx := context.Background()
-	if onDemand {
-		m.limiter.Wait(ctx)
-	} else {
-		m.limiter.WaitEvenly(ctx, "job")
-	}
+func (m *MatchFetcher) GetMatchTimelineData(ctx context.Context, matchId string, onDemand bool) (*MatchTimeline, error) {
+	requests.WaitLimiter(ctx, m.limiter, m.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/match/v5/matches/%s/timeline", m.region, matchId)
 
-	return requests.HandleAuthRequest[*MatchTimeline](m.apiKey, url, "GET", map[string]string{})
+	return requests.HandleAuthRequest[*MatchTimeline](ctx, m.apiKey, requests.EndpointMatchTimeline, url, "GET", map[string]string{})
 }
@@ -43,13 +43,8 @@ func NewSubPlayerFetcher(apiKey string, limiter *gomultirate.RateLimiter, region
 }
 
 // GetMatchList returns a players match list.
-func (p *PlayerFetcher) GetMatchList(puuid string, lastFetch time.Time, offset int, onDemand bool) ([]string, error) {
-	ctx := context.Background()
-	if onDemand {
-		p.limiter.Wait(ctx)
-	} else {
-		p.limiter.WaitEvenly(ctx, "job")
-	}
+func (p *PlayerFetcher) GetMatchList(ctx context.Context, puuid string, lastFetch time.Time, offset int, onDemand bool) ([]string, error) {
+	requests.WaitLimiter(ctx, p.limiter, p.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/match/v5/matches/by-puuid/%s/ids", p.region, puuid)
@@ -59,41 +54,31 @@ func (p *PlayerFetcher) GetMatchList(puuid string, lastFetch time.Time, offset i
 		"count":     "100", // 100 is the maximum allowed count.
 	}
 
-	return requests.HandleAuthRequest[[]string](p.apiKey, url, "GET", params)
+	return requests.HandleAuthRequest[[]string](ctx, p.apiKey, requests.EndpointMatchListByPuuid, url, "GET", params)
 }
 
 // GetPlayerAccount returns a given player account info.
-func (p *PlayerFetcher) GetPlayerAccount(gameName string, tagLine string, onDemand bool) (*Account, error) {
-	ctx := context.Background()
-	if onDemand {
-		p.limiter.Wait(ctx)
-	} else {
-		p.limiter.WaitEvenly(ctx, "job")
-	}
+func (p *PlayerFetcher) GetPlayerAccount(ctx context.Context, gameName string, tagLine string, onDemand bool) (*Account, error) {
+	requests.WaitLimiter(ctx, p.limiter, p.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/riot/account/v1/accounts/by-riot-id/%s/%s", p.region, gameName, tagLine)
 
 	params := map[string]string{}
 
-	account, err := requests.HandleAuthRequest[Account](p.apiKey, url, "GET", params)
+	account, err := requests.HandleAuthRequest[Account](ctx, p.apiKey, requests.EndpointAccountByRiotId, url, "GET", params)
 	return &account, err
 }
 
 // GetSummonerData returns a players summoner data.
-func (p *SubPlayerFetcher) GetSummonerDataByPuuid(puuid string, onDemand bool) (*SummonerByPuuid, error) {
-	ctx := context.Background()
-	if onDemand {
-		p.limiter.Wait(ctx)
-	} else {
-		p.limiter.WaitEvenly(ctx, "job")
-	}
+func (p *SubPlayerFetcher) GetSummonerDataByPuuid(ctx context.Context, puuid string, onDemand bool) (*SummonerByPuuid, error) {
+	requests.WaitLimiter(ctx, p.limiter, p.region, onDemand)
 
 	// Format the URL and create the params.
 	url := fmt.Sprintf("https://%s.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/%s", p.region, puuid)
 
 	params := map[string]string{}
 
-	summoner, err := requests.HandleAuthRequest[SummonerByPuuid](p.apiKey, url, "GET", params)
+	summoner, err := requests.HandleAuthRequest[SummonerByPuuid](ctx, p.apiKey, requests.EndpointSummonerByPuuid, url, "GET", params)
 	return &summoner, err
 }
@@ -8,13 +8,17 @@ import (
 	"goleague/pkg/database"
 	pb "goleague/pkg/grpc"
 	"goleague/pkg/logger"
+	"goleague/pkg/metrics"
+	"goleague/pkg/tracing"
 	"log"
+	"log/slog"
 	"net"
 	"os"
 	"os/signal"
 	"sync"
 	"syscall"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/health/grpc_health_v1"
@@ -26,11 +30,24 @@ func main() {
 		log.Fatalf("Couldn't initialize the configuration: %v", err)
 	}
 
+	// Every log without a dedicated logger goes through the structured one.
+	mainLogger, err := logger.NewStreaming(cfg, "service", "fetcher")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the logger: %v", err)
+	}
+	slog.SetDefault(mainLogger.Logger)
+
 	_, stop := context.WithCancel(context.Background())
 
 	defer stop()
 
-	log.Println("Running migration and creating triggers/enums...")
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing, "goleague-fetcher")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	mainLogger.Info("Running migration and creating triggers/enums")
 
 	// Creates the database connection.
 	db, err := database.NewConnection(cfg.Database.DSN)
@@ -48,7 +65,7 @@ func main() {
 		log.Fatal(err)
 	}
 
-	log.Println("Instanciating Region Managers...")
+	mainLogger.Info("Instanciating Region Managers")
 
 	// Pass down the necessary dependencies.
 	deps := regionmanager.RegionManagerDependencies{
@@ -61,12 +78,15 @@ func main() {
 		log.Fatal(err)
 	}
 
-	log.Println("Region Managers created...")
+	mainLogger.Info("Region Managers created")
 
-	log.Println("Starting the queues...")
+	mainLogger.Info("Starting the queues")
 	// Start the queue.
 	go queue.StartQueue(manager)
 
+	// Expose the metrics.
+	metrics.StartServer(cfg.Metrics.Port)
+
 	// Start the gRPC server.
 	grpcServer, healthServer := startGRPCServer(cfg, manager)
 
@@ -83,10 +103,11 @@ func startGRPCServer(config *config.Config, regionManager *regionmanager.RegionM
 	}
 
 	// Create the server, register it and serve.
-	grpcServer := grpc.NewServer()
+	// The stats handler continues the traces started by the API.
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
 	// Create a logger for thge gRPC server requests.
-	logger, err := logger.CreateLogger(config)
+	logger, err := logger.New(config, "service", "fetcher", "component", "grpc")
 	if err != nil {
 		log.Fatalf("Couldn't start the gRPC server logger: %v", err)
 	}
@@ -107,7 +128,7 @@ func startGRPCServer(config *config.Config, regionManager *regionmanager.RegionM
 
 	// Run a go routine for the grpc server.
 	go func() {
-		log.Println("Starting gRPC server...")
+		logger.Info("Starting gRPC server", "port", config.Grpc.Port)
 		if err := grpcServer.Serve(list); err != nil {
 			log.Fatalf("Failed to server grpc: %v", err)
 		}
@@ -20,7 +20,7 @@ const (
 type server struct {
 	pb.UnimplementedServiceServer
 	regionManager *regionmanager.RegionManager
-	logger        *logger.NewLogger
+	logger        *logger.Logger
 }
 
 // FetchMatchHistory forces the player match history processing.
@@ -36,19 +36,23 @@ func (s *server) FetchMatchHistory(ctx context.Context, req *pb.SummonerRequest)
 		return nil, err
 	}
 
-	player, err := mainRegionService.GetPlayerByNameTagRegion(req.GameName, req.TagLine, string(subRegion))
+	player, err := mainRegionService.GetPlayerByNameTagRegion(ctx, req.GameName, req.TagLine, string(subRegion))
 	if err != nil {
 		return nil, err
 	}
 
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		// Keep the trace of the request, but not its cancellation, since the response is sent right away.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), time.Minute)
 		defer cancel()
 		mainRegionService.ProcessPlayerHistory(ctx, player, subRegion, s.logger, MAX_CONCURRENCY, true)
 		if s.logger.GetNumberOfWrites() > MAX_GRPC_LOGS {
 			objectKey := fmt.Sprintf("grpc/%s.log", time.Now().Format("2006-01-02-15-04-05"))
 
-			s.logger.UploadToS3Bucket(objectKey)
+			if err := s.logger.UploadToS3Bucket(objectKey); err != nil {
+				s.logger.Error("Couldn't send the log to s3", "error", err)
+				s.logger.CleanFile()
+			}
 		}
 	}()
 
@@ -74,7 +78,7 @@ func (s *server) FetchSummonerData(ctx context.Context, req *pb.SummonerRequest)
 		return nil, err
 	}
 
-	account, err := mainRegionService.GetAccount(req.GameName, req.TagLine)
+	account, err := mainRegionService.GetAccount(ctx, req.GameName, req.TagLine)
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +88,12 @@ func (s *server) FetchSummonerData(ctx context.Context, req *pb.SummonerRequest)
 		return nil, err
 	}
 
-	summoner, err := subRegionService.ProcessSummonerData(account, true)
+	summoner, err := subRegionService.ProcessSummonerData(ctx, account, true)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't process summoner: %w", err)
 	}
 
-	_ = subRegionService.ProcessPlayerLeagueEntries(summoner.Puuid, true)
+	_ = subRegionService.ProcessPlayerLeagueEntries(ctx, summoner.Puuid, true)
 
 	// Convert fetcher response to gRPC response
 	response := &pb.Summoner{
@@ -103,3 +107,35 @@ func (s *server) FetchSummonerData(ctx context.Context, req *pb.SummonerRequest)
 
 	return response, nil
 }
+
+// FetchMatchTimeline fetches the timeline of a match that had it deferred during the ingestion.
+// The sub region comes from the match id prefix.
+func (s *server) FetchMatchTimeline(ctx context.Context, req *pb.MatchTimelineRequest) (*pb.MatchTimelineNotification, error) {
+	prefix, _, found := strings.Cut(req.MatchId, "_")
+	if !found {
+		return nil, fmt.Errorf("invalid match id %s", req.MatchId)
+	}
+
+	mainRegion, err := s.regionManager.GetMainRegion(regions.SubRegion(strings.ToUpper(prefix)))
+	if err != nil {
+		return nil, err
+	}
+
+	mainRegionService, err := s.regionManager.GetMainService(mainRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, err := mainRegionService.FetchDeferredTimeline(ctx, req.MatchId)
+	if err != nil {
+		s.logger.Error("Couldn't fetch the deferred timeline", "match_id", req.MatchId, "error", err)
+		return nil, err
+	}
+
+	response := &pb.MatchTimelineNotification{
+		Message: "Match timeline fetched",
+		Fetched: fetched,
+	}
+
+	return response, nil
+}
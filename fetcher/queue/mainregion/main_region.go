@@ -8,7 +8,7 @@ import (
 	"goleague/pkg/database/models"
 	"goleague/pkg/logger"
 	"goleague/pkg/regions"
-	"log"
+	"log/slog"
 	"time"
 )
 
@@ -21,7 +21,7 @@ type MainRegionQueueConfig struct {
 type MainRegionQueue struct {
 	config         MainRegionQueueConfig
 	fetchedMatches int
-	logger         *logger.NewLogger
+	logger         *logger.Logger
 	mainRegion     regions.MainRegion
 	service        mainregionservice.MainRegionService
 	subRegions     []regions.SubRegion
@@ -39,15 +39,15 @@ func NewMainRegionQueue(region regions.MainRegion, rm *regionmanager.RegionManag
 	// Create the service.
 	service, err := rm.GetMainService(region)
 	if err != nil {
-		log.Printf("Failed to get main service for %v: %v", region, err)
+		slog.Error("Failed to get the main region service", "region", region, "error", err)
 		return nil, err
 	}
 
 	// Get the sub regions to define which region must be fetched.
-	subRegions, err := rm.GetSubRegions(region)
-	if err != nil {
-		log.Printf("Failed to get the sub regions for: %v: %v", region, err)
-		return nil, err
+	// On demand only sub regions are ignored by the background queue.
+	subRegions := rm.GetBackgroundSubRegions(region)
+	if len(subRegions) == 0 {
+		return nil, fmt.Errorf("no background sub regions enabled for %s", region)
 	}
 
 	logger := service.GetLogger()
@@ -64,19 +64,20 @@ func NewMainRegionQueue(region regions.MainRegion, rm *regionmanager.RegionManag
 
 // Run starts the main region.
 func (q *MainRegionQueue) Run() {
+	ctx := context.Background()
 	startTime := time.Now()
 
 	// Infinite loop, must be always getting data.
 	for {
 		// Loop through each possible subRegion so we can get a evenly distributed amount of matches.
 		for _, subRegion := range q.subRegions {
-			player, err := q.processQueue(subRegion)
+			player, err := q.processQueue(ctx, subRegion)
 			if err == nil || player == nil {
 				continue
 			}
 			// Delay the player next fetch to avoid the queue getting stuck.
-			if err := q.service.PlayerRepository.SetDelayedLastFetch(player.ID); err != nil {
-				q.logger.Errorf("Couldn't delay the next fetch for the player.")
+			if err := q.service.PlayerRepository.SetDelayedLastFetch(ctx, player.ID); err != nil {
+				q.logger.Error("Couldn't delay the next fetch for the player", "player_id", player.ID, "error", err)
 			}
 
 		}
@@ -86,15 +87,16 @@ func (q *MainRegionQueue) Run() {
 		}
 
 		// if we processed 100 matches, upload the log and continue fetching.
-		q.logger.EmptyLine()
-		q.logger.Infof("Finished executing after %v minutes.", time.Since(startTime).Minutes())
-
-		objectKey := fmt.Sprintf("mainregions/%s/%s.log", q.mainRegion, time.Now().Format("2006-01-02-15-04-05"))
-		if err := q.logger.UploadToS3Bucket(objectKey); err != nil {
-			log.Printf("Couldn't send the log to s3: %v", err)
-			q.logger.CleanFile()
-		} else {
-			log.Printf("Successfully sent log to s3 with key: %s", objectKey)
+		q.logger.Info("Finished executing", "minutes", time.Since(startTime).Minutes())
+
+		if q.logger.GetNumberOfWrites() > 0 {
+			objectKey := fmt.Sprintf("mainregions/%s/%s.log", q.mainRegion, time.Now().Format("2006-01-02-15-04-05"))
+			if err := q.logger.UploadToS3Bucket(objectKey); err != nil {
+				q.logger.Error("Couldn't send the log to s3", "error", err)
+				q.logger.CleanFile()
+			} else {
+				q.logger.Info("Successfully sent log to s3", "object_key", objectKey)
+			}
 		}
 
 		q.fetchedMatches = 0
@@ -103,22 +105,19 @@ func (q *MainRegionQueue) Run() {
 }
 
 // processQueue gets a unfetched player and starts processing it's matches.
-func (q *MainRegionQueue) processQueue(subRegion regions.SubRegion) (*models.PlayerInfo, error) {
-	player, err := q.service.PlayerRepository.GetNextFetchPlayerBySubRegion(subRegion)
+func (q *MainRegionQueue) processQueue(ctx context.Context, subRegion regions.SubRegion) (*models.PlayerInfo, error) {
+	player, err := q.service.PlayerRepository.GetNextFetchPlayerBySubRegion(ctx, subRegion)
 	if err != nil {
-		q.logger.Errorf("Couldn't get any unfetched player on regions %v: %v", subRegion, err)
+		q.logger.Error("Couldn't get any unfetched player", "sub_region", subRegion, "error", err)
 		// Could be the first fetch, wait to the sub regions to start filling the database.
 		time.Sleep(q.config.SleepDuration)
 		return nil, err
 	}
 
-	q.logger.EmptyLine()
-	q.logger.Infof("Starting fetching for player: %v", player.ID)
-	q.logger.EmptyLine()
+	q.logger.Info("Starting fetching for player", "sub_region", subRegion, "player_id", player.ID)
 
 	// Background fetching needs only 1 worker at a time.
 	jobWorkers := 1
-	ctx := context.Background()
 	player, fetched, err := q.service.ProcessPlayerHistory(ctx, player, subRegion, q.logger, jobWorkers, false)
 	q.fetchedMatches += fetched
 
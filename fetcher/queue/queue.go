@@ -5,16 +5,23 @@ import (
 	subregionqueue "goleague/fetcher/queue/subregion"
 	regionmanager "goleague/fetcher/regionmanager"
 	"goleague/pkg/regions"
-	"log"
+	"log/slog"
 	"sync"
 )
 
 // StartQueue is the main process of the fetcher.
-// Initialize all subregions and main region queues.
+// Initialize the queues of every region configured to run in background.
+// On demand only regions are still served through the gRPC server.
 func StartQueue(rm *regionmanager.RegionManager) {
 	var wg sync.WaitGroup
 	// Loop through each main region and start it's queue.
-	for mainRegion, subRegions := range regions.RegionList {
+	for mainRegion := range rm.GetRegionConfig().Enabled() {
+		subRegions := rm.GetBackgroundSubRegions(mainRegion)
+		if len(subRegions) == 0 {
+			slog.Info("Skipping the background queues, no background sub regions enabled", "region", mainRegion)
+			continue
+		}
+
 		wg.Add(1)
 
 		// Start the queue.
@@ -23,7 +30,7 @@ func StartQueue(rm *regionmanager.RegionManager) {
 			// Create the main region queue instance.
 			queue, err := mainregionqueue.NewMainRegionQueue(region, rm)
 			if err != nil {
-				log.Printf("Something went wrong at queue start for region %s: %v", region, err)
+				slog.Error("Couldn't start the queue", "region", region, "error", err)
 				return
 			}
 
@@ -41,7 +48,7 @@ func StartQueue(rm *regionmanager.RegionManager) {
 				// Create the subregion queue instance.
 				queue, err := subregionqueue.NewSubRegionQueue(sr, rm)
 				if err != nil {
-					log.Printf("Something went wrong at queue start for subregion %s: %v", sr, err)
+					slog.Error("Couldn't start the queue", "sub_region", sr, "error", err)
 					return
 				}
 
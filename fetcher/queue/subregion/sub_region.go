@@ -1,12 +1,13 @@
 package subregionqueue
 
 import (
+	"context"
 	"fmt"
 	regionmanager "goleague/fetcher/regionmanager"
 	subregionservice "goleague/fetcher/services/subregion"
 	"goleague/pkg/logger"
 	"goleague/pkg/regions"
-	"log"
+	"log/slog"
 	"time"
 )
 
@@ -22,13 +23,16 @@ type TierPriority struct {
 	tier              string
 	ranks             []string
 	pagesPerTierCycle int
-	currentPage       int
 }
 
 // SubRegionQueue is the type for the sub region main process.
 type SubRegionQueue struct {
-	config    SubRegionQueueConfig
-	logger    *logger.NewLogger
+	config SubRegionQueueConfig
+
+	// Next page to be fetched for each queue, tier and rank.
+	cursors map[string]int
+
+	logger    *logger.Logger
 	service   subregionservice.SubRegionService
 	subRegion regions.SubRegion
 }
@@ -40,19 +44,19 @@ func NewDefaultQueueConfig() *SubRegionQueueConfig {
 		SleepDuration: 60 * time.Minute,
 		tierPriority: []TierPriority{
 			// High elos, get all possible ranking plages for each full cycle.
-			{tier: "CHALLENGER", ranks: []string{"I"}, pagesPerTierCycle: 999, currentPage: 1},
-			{tier: "GRANDMASTER", ranks: []string{"I"}, pagesPerTierCycle: 999, currentPage: 1},
-			{tier: "MASTER", ranks: []string{"I"}, pagesPerTierCycle: 999, currentPage: 1},
+			{tier: "CHALLENGER", ranks: []string{"I"}, pagesPerTierCycle: 999},
+			{tier: "GRANDMASTER", ranks: []string{"I"}, pagesPerTierCycle: 999},
+			{tier: "MASTER", ranks: []string{"I"}, pagesPerTierCycle: 999},
 
-			{tier: "DIAMOND", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 30, currentPage: 1},
-			{tier: "EMERALD", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 10, currentPage: 1},
-			{tier: "PLATINUM", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 5, currentPage: 1},
+			{tier: "DIAMOND", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 30},
+			{tier: "EMERALD", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 10},
+			{tier: "PLATINUM", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 5},
 
 			// Less prioritized, only 2 pages per tier (8 total)
-			{tier: "GOLD", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2, currentPage: 1},
-			{tier: "SILVER", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2, currentPage: 1},
-			{tier: "BRONZE", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2, currentPage: 1},
-			{tier: "IRON", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2, currentPage: 1},
+			{tier: "GOLD", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2},
+			{tier: "SILVER", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2},
+			{tier: "BRONZE", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2},
+			{tier: "IRON", ranks: []string{"I", "II", "III", "IV"}, pagesPerTierCycle: 2},
 		},
 	}
 }
@@ -62,21 +66,38 @@ func NewSubRegionQueue(region regions.SubRegion, rm *regionmanager.RegionManager
 	// Create the service.
 	service, err := rm.GetSubService(region)
 	if err != nil {
-		log.Printf("Failed to get sub region fetcher for %v: %v", region, err)
+		slog.Error("Failed to get the sub region service", "sub_region", region, "error", err)
 		return nil, err
 	}
 
 	logger := service.GetLogger()
 
+	// Resume the pagination from where the last run stopped.
+	savedCursors, err := service.GetCrawlCursors(context.Background())
+	if err != nil {
+		logger.Warn("Failed to load the crawl cursors, starting from the first page", "error", err)
+	}
+
+	cursors := make(map[string]int, len(savedCursors))
+	for _, cursor := range savedCursors {
+		cursors[cursorKey(cursor.Queue, cursor.Tier, cursor.Rank)] = cursor.CurrentPage
+	}
+
 	// Return the new region service.
 	return &SubRegionQueue{
 		config:    *NewDefaultQueueConfig(),
+		cursors:   cursors,
 		logger:    logger,
 		service:   *service,
 		subRegion: region,
 	}, nil
 }
 
+// cursorKey returns the key of a league in the cursor map.
+func cursorKey(queue string, tier string, rank string) string {
+	return fmt.Sprintf("%s:%s:%s", queue, tier, rank)
+}
+
 // Run starts the sub region queue.
 // Mainly responsible for getting the ratings for each player on the region.
 func (q *SubRegionQueue) Run() {
@@ -84,16 +105,18 @@ func (q *SubRegionQueue) Run() {
 		startTime := time.Now()
 		q.processQueues()
 
-		q.logger.Infof("Finished executing after %v minutes.", time.Since(startTime).Minutes())
+		q.logger.Info("Finished executing", "minutes", time.Since(startTime).Minutes())
 
-		objectKey := fmt.Sprintf("subregions/%s/%s.log", q.subRegion, time.Now().Format("2006-01-02-15-04"))
-		if err := q.logger.UploadToS3Bucket(objectKey); err != nil {
-			log.Printf("Couldn't send the log to s3: %v", err)
+		if q.logger.GetNumberOfWrites() > 0 {
+			objectKey := fmt.Sprintf("subregions/%s/%s.log", q.subRegion, time.Now().Format("2006-01-02-15-04"))
+			if err := q.logger.UploadToS3Bucket(objectKey); err != nil {
+				q.logger.Error("Couldn't send the log to s3", "error", err)
 
-			// Clean the file in the case it was a S3 error and not a file error.
-			q.logger.CleanFile()
-		} else {
-			log.Printf("Successfully sent log to s3 with key: %s", objectKey)
+				// Clean the file in the case it was a S3 error and not a file error.
+				q.logger.CleanFile()
+			} else {
+				q.logger.Info("Successfully sent log to s3", "object_key", objectKey)
+			}
 		}
 
 		// Sleep to wait new matches to happen.
@@ -102,43 +125,85 @@ func (q *SubRegionQueue) Run() {
 }
 
 // processQueues process the leagues for the SoloDuo and Flex queue.
+// The crawl cycle is only marked as completed when every tier and rank was processed without errors.
 func (q *SubRegionQueue) processQueues() {
+	ctx := context.Background()
+
+	completed := true
 	for _, queue := range q.config.Queues {
-		q.processLeagues(queue)
+		if !q.processLeagues(ctx, queue) {
+			completed = false
+		}
+	}
+
+	if !completed {
+		q.logger.Info("Crawl cycle had failures, not marking it as completed")
+		return
+	}
+
+	if err := q.service.SetCrawlCycleCompleted(ctx); err != nil {
+		q.logger.Error("Couldn't save the crawl cycle completion", "error", err)
 	}
 }
 
 // processLeagues process each league and sub rank.
-func (q *SubRegionQueue) processLeagues(queue string) {
+// Returns false if any of the tier and rank combinations failed.
+func (q *SubRegionQueue) processLeagues(ctx context.Context, queue string) bool {
+	completed := true
+
 	// Loop through each available tier.
 	for i := range q.config.tierPriority {
 		tier := &q.config.tierPriority[i]
 		// Loop through each available rank.
 		for _, rank := range tier.ranks {
-			q.logger.EmptyLine()
-			q.logger.Infof("Starting fetching on %s-%s: Queue(%s)", tier.tier, rank, queue)
-			q.logger.EmptyLine()
+			q.logger.Info("Starting fetching", "queue", queue, "tier", tier.tier, "rank", rank)
 
-			q.processTierRank(queue, tier, rank)
+			if !q.processTierRank(ctx, queue, tier, rank) {
+				completed = false
+			}
 		}
 	}
+
+	return completed
 }
 
 // processTierRank handles the pagination to process the defined amount of league pages for each tier + rank.
-func (q *SubRegionQueue) processTierRank(queue string, tier *TierPriority, rank string) {
-	finalPageCycle := tier.currentPage + tier.pagesPerTierCycle
-	for tier.currentPage < finalPageCycle {
-		isLastPage, err := q.service.ProcessLeagueRank(tier.tier, rank, queue, tier.currentPage)
+// The cursor is persisted after each page, so a restart resumes from the same point.
+// Returns false if a page couldn't be processed.
+func (q *SubRegionQueue) processTierRank(ctx context.Context, queue string, tier *TierPriority, rank string) bool {
+	currentPage := max(q.cursors[cursorKey(queue, tier.tier, rank)], 1)
+
+	finalPageCycle := currentPage + tier.pagesPerTierCycle
+	for currentPage < finalPageCycle {
+		isLastPage, err := q.service.ProcessLeagueRank(ctx, tier.tier, rank, queue, currentPage)
 		if err != nil {
-			q.logger.Errorf("Couldn't process the league %s - rank %s for the queue %s on region %s: %v", tier.tier, rank, queue, q.subRegion, err)
-			return
+			q.logger.Error("Couldn't process the league",
+				"queue", queue,
+				"tier", tier.tier,
+				"rank", rank,
+				"page", currentPage,
+				"error", err,
+			)
+			return false
 		}
 
 		if isLastPage {
-			tier.currentPage = 1
-			break
+			q.saveCursor(ctx, queue, tier.tier, rank, 1)
+			return true
 		}
 
-		tier.currentPage++
+		currentPage++
+		q.saveCursor(ctx, queue, tier.tier, rank, currentPage)
+	}
+
+	return true
+}
+
+// saveCursor updates the next page of a league in memory and in the database.
+func (q *SubRegionQueue) saveCursor(ctx context.Context, queue string, tier string, rank string, page int) {
+	q.cursors[cursorKey(queue, tier, rank)] = page
+
+	if err := q.service.SaveCrawlCursor(ctx, queue, tier, rank, page); err != nil {
+		q.logger.Error("Couldn't save the crawl cursor", "queue", queue, "tier", tier, "rank", rank, "error", err)
 	}
 }
@@ -33,17 +33,21 @@ type RegionManager struct {
 	// Passed necessary dependencies.
 	deps RegionManagerDependencies
 
+	// Configured regions and their background toggles.
+	regionConfig *regions.RegionConfig
+
 	mu sync.RWMutex
 }
 
 // NewRegionManager creates a new region manager instance.
 func NewRegionManager(config *config.Config, deps RegionManagerDependencies) (*RegionManager, error) {
 	rm := &RegionManager{
-		subToMain:   make(map[regions.SubRegion]regions.MainRegion),
-		mainToSub:   make(map[regions.MainRegion][]regions.SubRegion),
-		mainService: make(map[regions.MainRegion]*mainregionservice.MainRegionService),
-		subService:  make(map[regions.SubRegion]*subregionservice.SubRegionService),
-		deps:        deps,
+		subToMain:    make(map[regions.SubRegion]regions.MainRegion),
+		mainToSub:    make(map[regions.MainRegion][]regions.SubRegion),
+		mainService:  make(map[regions.MainRegion]*mainregionservice.MainRegionService),
+		subService:   make(map[regions.SubRegion]*subregionservice.SubRegionService),
+		deps:         deps,
+		regionConfig: config.Regions,
 	}
 
 	if err := rm.initialize(config); err != nil {
@@ -55,7 +59,7 @@ func NewRegionManager(config *config.Config, deps RegionManagerDependencies) (*R
 
 // initialize creates the instances for the main regions and subregions.
 func (rm *RegionManager) initialize(config *config.Config) error {
-	for mainRegion, subRegions := range regions.RegionList {
+	for mainRegion, subRegions := range config.Regions.Enabled() {
 		if err := rm.initializeMainRegion(config, mainRegion, subRegions); err != nil {
 			return fmt.Errorf("failed to initialize main region %s: %w", mainRegion, err)
 		}
@@ -164,3 +168,13 @@ func (m *RegionManager) GetSubRegions(MainRegion regions.MainRegion) ([]regions.
 
 	return SubRegions, nil
 }
+
+// GetBackgroundSubRegions returns the Sub Regions of a Main Region that run the background queues.
+func (m *RegionManager) GetBackgroundSubRegions(MainRegion regions.MainRegion) []regions.SubRegion {
+	return m.regionConfig.BackgroundSubRegions(MainRegion)
+}
+
+// GetRegionConfig returns the configured regions.
+func (m *RegionManager) GetRegionConfig() *regions.RegionConfig {
+	return m.regionConfig
+}
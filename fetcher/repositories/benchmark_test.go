@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"goleague/internal/testutil"
+	"goleague/pkg/database"
+	"goleague/pkg/database/models"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Sizes of a regular match timeline, around 300 frames and 1000 events.
+const (
+	benchmarkParticipants = 10
+	benchmarkFrames       = 30
+	benchmarkItemEvents   = 600
+	benchmarkSkillEvents  = 180
+	benchmarkLevelEvents  = 180
+	benchmarkWardEvents   = 40
+)
+
+// BenchmarkTimelineInsertion compares the batched inserts with COPY, both replacing the timeline of a match.
+func BenchmarkTimelineInsertion(b *testing.B) {
+	db, cleanup := testutil.NewTestConnection(b)
+	defer cleanup()
+
+	matchInfo, statIds := seedTimelineMatch(b, db, "BENCH_TIMELINE", time.Now().UTC())
+	frames, insertEvents := newBenchmarkTimeline(matchInfo, statIds)
+
+	ingest := func(tx *gorm.DB) error {
+		repository, err := NewTimelineRepository(tx)
+		if err != nil {
+			return err
+		}
+
+		ctx := tx.Statement.Context
+		if err := repository.DeleteMatchTimeline(ctx, matchInfo.ID, matchInfo.MatchStart); err != nil {
+			return err
+		}
+
+		if err := repository.CreateBatchParticipantFrame(ctx, frames); err != nil {
+			return err
+		}
+
+		return insertEvents(tx)
+	}
+
+	benchmarks := []struct {
+		name        string
+		transaction func(fn func(tx *gorm.DB) error) error
+	}{
+		{
+			name:        "batch",
+			transaction: func(fn func(tx *gorm.DB) error) error { return db.Transaction(fn) },
+		},
+		{
+			name: "copy",
+			transaction: func(fn func(tx *gorm.DB) error) error {
+				return database.CopyTransaction(context.Background(), db, fn)
+			},
+		},
+	}
+
+	for _, bb := range benchmarks {
+		b.Run(bb.name, func(b *testing.B) {
+			matches := 0
+			for b.Loop() {
+				if err := bb.transaction(ingest); err != nil {
+					b.Fatal(err)
+				}
+				matches++
+			}
+
+			b.ReportMetric(float64(matches)/b.Elapsed().Seconds(), "matches/s")
+		})
+	}
+}
+
+// seedTimelineMatch creates a match with its players and stats, returning the stat ids.
+func seedTimelineMatch(tb testing.TB, db *gorm.DB, matchId string, matchStart time.Time) (*models.MatchInfo, []uint64) {
+	tb.Helper()
+
+	matchInfo := &models.MatchInfo{
+		MatchId:    matchId,
+		MatchStart: matchStart,
+		QueueId:    420,
+	}
+	if err := db.Create(matchInfo).Error; err != nil {
+		tb.Fatal(err)
+	}
+
+	statIds := make([]uint64, benchmarkParticipants)
+	for i := range benchmarkParticipants {
+		player := &models.PlayerInfo{
+			Puuid:  fmt.Sprintf("%s-%d", matchId, i),
+			Region: "BR1",
+		}
+		if err := db.Create(player).Error; err != nil {
+			tb.Fatal(err)
+		}
+
+		stat := &models.MatchStats{
+			MatchId:    matchInfo.ID,
+			PlayerId:   player.ID,
+			MatchStart: matchInfo.MatchStart,
+		}
+		stat.PlayerData.ParticipantId = i + 1
+		if err := db.Omit("Match", "Player").Create(stat).Error; err != nil {
+			tb.Fatal(err)
+		}
+
+		statIds[i] = stat.ID
+	}
+
+	return matchInfo, statIds
+}
+
+// newBenchmarkTimeline returns the frames of the match and a function inserting its events.
+func newBenchmarkTimeline(matchInfo *models.MatchInfo, statIds []uint64) ([]*models.ParticipantFrame, func(tx *gorm.DB) error) {
+	frames := make([]*models.ParticipantFrame, 0, benchmarkParticipants*benchmarkFrames)
+	for i, statId := range statIds {
+		for frame := range benchmarkFrames {
+			frames = append(frames, &models.ParticipantFrame{
+				MatchStatId:   statId,
+				FrameIndex:    frame,
+				MatchStart:    matchInfo.MatchStart,
+				CurrentGold:   frame * 100,
+				Level:         frame/2 + 1,
+				MinionsKilled: frame * 7,
+				ParticipantId: i + 1,
+				TotalGold:     frame * 400,
+				XP:            frame * 350,
+			})
+		}
+	}
+
+	eventBase := func(i int) models.EventBase {
+		participantId := i%benchmarkParticipants + 1
+		return models.EventBase{
+			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
+			ParticipantID: &participantId,
+			Timestamp:     int64(i * 1000),
+		}
+	}
+
+	items := make([]*models.EventItem, benchmarkItemEvents)
+	for i := range items {
+		items[i] = &models.EventItem{EventBase: eventBase(i), ItemId: 1000 + i%200, Action: "ITEM_PURCHASED"}
+	}
+
+	skills := make([]*models.EventSkillLevelUp, benchmarkSkillEvents)
+	for i := range skills {
+		skills[i] = &models.EventSkillLevelUp{EventBase: eventBase(i), LevelUpType: "NORMAL", SkillSlot: i%4 + 1}
+	}
+
+	levels := make([]*models.EventLevelUp, benchmarkLevelEvents)
+	for i := range levels {
+		levels[i] = &models.EventLevelUp{EventBase: eventBase(i), Level: i/benchmarkParticipants + 2}
+	}
+
+	wardType := "YELLOW_TRINKET"
+	wards := make([]*models.EventWard, benchmarkWardEvents)
+	for i := range wards {
+		wards[i] = &models.EventWard{EventBase: eventBase(i), EventType: "WARD_PLACED", WardType: &wardType}
+	}
+
+	insertEvents := func(tx *gorm.DB) error {
+		if err := CreateEventBatch(tx, items); err != nil {
+			return err
+		}
+		if err := CreateEventBatch(tx, skills); err != nil {
+			return err
+		}
+		if err := CreateEventBatch(tx, levels); err != nil {
+			return err
+		}
+		return CreateEventBatch(tx, wards)
+	}
+
+	return frames, insertEvents
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"goleague/pkg/database/models"
 
 	"gorm.io/gorm"
@@ -9,7 +10,7 @@ import (
 
 // Public Interface.
 type CacheRepository interface {
-	Setkey(key string, value string) error
+	Setkey(ctx context.Context, key string, value string) error
 }
 
 // cacheRepository structure.
@@ -24,14 +25,14 @@ func NewCacheRepository(db *gorm.DB) (CacheRepository, error) {
 
 // SetKey sets the given key value.
 // Should be used as a Redis fallback.
-func (cr *cacheRepository) Setkey(key string, value string) error {
+func (cr *cacheRepository) Setkey(ctx context.Context, key string, value string) error {
 	cacheEntry := &models.CacheBackup{
 		CacheKey:   key,
 		CacheValue: value,
 	}
 
 	// Upsert the cache  key.
-	return cr.db.Clauses(clause.OnConflict{
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "cache_key"}},
 		DoUpdates: clause.AssignmentColumns([]string{"cache_value"}),
 	}).Create(cacheEntry).Error
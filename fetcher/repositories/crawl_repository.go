@@ -0,0 +1,66 @@
+package repositories
+
+import (
+	"context"
+	"goleague/pkg/database/models"
+	"goleague/pkg/regions"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CrawlRepository is the public interface for handling the league crawl state.
+type CrawlRepository interface {
+	GetCursorsBySubRegion(ctx context.Context, subRegion regions.SubRegion) ([]models.LeagueCrawlCursor, error)
+	SetCycleCompleted(ctx context.Context, subRegion regions.SubRegion, completedAt time.Time) error
+	UpsertCursor(ctx context.Context, cursor *models.LeagueCrawlCursor) error
+}
+
+// crawlRepository is the repository instance.
+type crawlRepository struct {
+	db *gorm.DB
+}
+
+// NewCrawlRepository creates a new repository and return it.
+func NewCrawlRepository(db *gorm.DB) (CrawlRepository, error) {
+	return &crawlRepository{db: db}, nil
+}
+
+// GetCursorsBySubRegion returns all saved cursors of a sub region.
+func (cr *crawlRepository) GetCursorsBySubRegion(ctx context.Context, subRegion regions.SubRegion) ([]models.LeagueCrawlCursor, error) {
+	var cursors []models.LeagueCrawlCursor
+	if err := cr.db.WithContext(ctx).Where("region = ?", subRegion).Find(&cursors).Error; err != nil {
+		return nil, err
+	}
+
+	return cursors, nil
+}
+
+// SetCycleCompleted saves the time a sub region finished a full league cycle.
+func (cr *crawlRepository) SetCycleCompleted(ctx context.Context, subRegion regions.SubRegion, completedAt time.Time) error {
+	cycle := models.LeagueCrawlCycle{
+		Region:          subRegion,
+		LastCompletedAt: completedAt,
+	}
+
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "region"}},
+		DoUpdates: clause.AssignmentColumns([]string{"last_completed_at"}),
+	}).Create(&cycle).Error
+}
+
+// UpsertCursor creates or updates the current page of a given league.
+func (cr *crawlRepository) UpsertCursor(ctx context.Context, cursor *models.LeagueCrawlCursor) error {
+	cursor.UpdatedAt = time.Now()
+
+	return cr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "region"},
+			{Name: "queue"},
+			{Name: "tier"},
+			{Name: "rank"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"current_page", "updated_at"}),
+	}).Create(cursor).Error
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"context"
+	"goleague/pkg/database/models"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Backoff applied between the attempts of a transient failure.
+const (
+	failedMatchBaseRetry   = 10 * time.Minute
+	failedMatchMaxRetry    = 24 * time.Hour
+	failedMatchMaxAttempts = 8
+)
+
+// FailedMatchRepository is the public interface for tracking the matches that couldn't be ingested.
+type FailedMatchRepository interface {
+	DeleteFailure(ctx context.Context, matchId string) error
+	GetRetriedMatches(ctx context.Context, riotMatchIDs []string) ([]string, error)
+	GetSkippedMatches(ctx context.Context, riotMatchIDs []string) ([]string, error)
+	RecordFailure(ctx context.Context, failure *models.FailedMatch) error
+}
+
+// failedMatchRepository is the repository instance.
+type failedMatchRepository struct {
+	db *gorm.DB
+}
+
+// NewFailedMatchRepository creates and returns a failed match repository.
+func NewFailedMatchRepository(db *gorm.DB) (FailedMatchRepository, error) {
+	return &failedMatchRepository{db: db}, nil
+}
+
+// DeleteFailure removes the failure of a match that was later ingested.
+func (fr *failedMatchRepository) DeleteFailure(ctx context.Context, matchId string) error {
+	return fr.db.WithContext(ctx).Where("match_id = ?", matchId).Delete(&models.FailedMatch{}).Error
+}
+
+// GetRetriedMatches returns which matches from the received array failed before and are due for a new attempt.
+func (fr *failedMatchRepository) GetRetriedMatches(ctx context.Context, riotMatchIDs []string) ([]string, error) {
+	return fr.pluckFailures(ctx, riotMatchIDs, "NOT permanent AND next_retry_at <= ?")
+}
+
+// GetSkippedMatches returns which matches from the received array must not be fetched right now.
+func (fr *failedMatchRepository) GetSkippedMatches(ctx context.Context, riotMatchIDs []string) ([]string, error) {
+	return fr.pluckFailures(ctx, riotMatchIDs, "permanent OR next_retry_at > ?")
+}
+
+// pluckFailures returns the matches from the received array with a failure matching the retry condition.
+func (fr *failedMatchRepository) pluckFailures(ctx context.Context, riotMatchIDs []string, retryCondition string) ([]string, error) {
+	const batchSize = 1000
+	var matches []string
+	now := time.Now()
+
+	for i := 0; i < len(riotMatchIDs); i += batchSize {
+		end := min(i+batchSize, len(riotMatchIDs))
+
+		var batch []string
+		result := fr.db.WithContext(ctx).Model(&models.FailedMatch{}).
+			Where("match_id IN (?)", riotMatchIDs[i:end]).
+			Where(retryCondition, now).
+			Pluck("match_id", &batch)
+		if result.Error != nil {
+			return nil, result.Error
+		}
+
+		matches = append(matches, batch...)
+	}
+
+	return matches, nil
+}
+
+// RecordFailure creates or updates the failure of a match.
+// Each new attempt doubles the wait until the next retry, giving up after the max attempts.
+func (fr *failedMatchRepository) RecordFailure(ctx context.Context, failure *models.FailedMatch) error {
+	now := time.Now()
+	failure.Attempts = 1
+	failure.NextRetryAt = now.Add(failedMatchBaseRetry)
+	failure.CreatedAt = now
+	failure.UpdatedAt = now
+
+	return fr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "match_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "reason"}, Value: gorm.Expr("EXCLUDED.reason")},
+			{Column: clause.Column{Name: "last_error"}, Value: gorm.Expr("EXCLUDED.last_error")},
+			{Column: clause.Column{Name: "attempts"}, Value: gorm.Expr("failed_matches.attempts + 1")},
+			{
+				Column: clause.Column{Name: "permanent"},
+				Value:  gorm.Expr("EXCLUDED.permanent OR failed_matches.attempts + 1 >= ?", failedMatchMaxAttempts),
+			},
+			{
+				Column: clause.Column{Name: "next_retry_at"},
+				Value: gorm.Expr(
+					"EXCLUDED.updated_at + LEAST(? * POWER(2, failed_matches.attempts), ?) * INTERVAL '1 second'",
+					failedMatchBaseRetry.Seconds(),
+					failedMatchMaxRetry.Seconds(),
+				),
+			},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("EXCLUDED.updated_at")},
+		},
+	}).Create(failure).Error
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"goleague/pkg/database/models"
 
 	"gorm.io/gorm"
@@ -9,14 +10,17 @@ import (
 
 // MatchRepository defines the public interface to interact with match data.
 type MatchRepository interface {
-	CreateMatchBans(bans []*models.MatchBans) error
-	CreateMatchInfo(match *models.MatchInfo) error
-	CreateMatchStats(stats []*models.MatchStats) error
-	GetAlreadyFetchedMatches(riotMatchIDs []string) ([]models.MatchInfo, error)
-	SetAverageRating(matchID uint, rating float64) error
-	SetFrameInterval(matchID uint, interval int64) error
-	SetFullyFetched(matchID uint) error
-	SetMatchWinner(matchID uint, winner int) error
+	CreateMatchBans(ctx context.Context, bans []*models.MatchBans) error
+	CreateMatchInfo(ctx context.Context, match *models.MatchInfo) error
+	CreateMatchStats(ctx context.Context, stats []*models.MatchStats) error
+	GetAlreadyFetchedMatches(ctx context.Context, riotMatchIDs []string) ([]models.MatchInfo, error)
+	GetMatchByMatchId(ctx context.Context, riotMatchID string) (*models.MatchInfo, error)
+	GetMatchForUpdate(ctx context.Context, matchID uint) (*models.MatchInfo, error)
+	GetStatIdsByPuuid(ctx context.Context, matchID uint) (map[string]uint64, error)
+	SetAverageRating(ctx context.Context, matchID uint, rating float64) error
+	SetFrameInterval(ctx context.Context, matchID uint, interval int64) error
+	SetFullyFetched(ctx context.Context, matchID uint) error
+	SetMatchWinner(ctx context.Context, matchID uint, winner int) error
 }
 
 // matchRepository is the repository instance.
@@ -30,28 +34,28 @@ func NewMatchRepository(db *gorm.DB) (MatchRepository, error) {
 }
 
 // CreateMatchBans inserts the bans in the database. Ignore duplicate picks for a given match.
-func (mr *matchRepository) CreateMatchBans(bans []*models.MatchBans) error {
-	return mr.db.Clauses(clause.OnConflict{
+func (mr *matchRepository) CreateMatchBans(ctx context.Context, bans []*models.MatchBans) error {
+	return mr.db.WithContext(ctx).Clauses(clause.OnConflict{
 		Columns:   []clause.Column{{Name: "match_id"}, {Name: "pick_turn"}}, // Use the composite key columns
 		DoNothing: true,
 	}).Create(&bans).Error
 }
 
 // CreateMatchInfo creates a match metadata into the database and return the returned error.
-func (mr *matchRepository) CreateMatchInfo(match *models.MatchInfo) error {
-	return mr.db.Create(&match).Error
+func (mr *matchRepository) CreateMatchInfo(ctx context.Context, match *models.MatchInfo) error {
+	return mr.db.WithContext(ctx).Create(&match).Error
 }
 
 // CreateMatchStats insert stats entries in the database. Ignores duplicate entries for a player in a given match.
-func (mr *matchRepository) CreateMatchStats(stats []*models.MatchStats) error {
-	return mr.db.Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "match_id"}, {Name: "player_id"}}, // Use the composite key columns
+func (mr *matchRepository) CreateMatchStats(ctx context.Context, stats []*models.MatchStats) error {
+	return mr.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "match_id"}, {Name: "player_id"}, {Name: "match_start"}}, // Use the composite key columns
 		DoNothing: true,
 	}).Create(&stats).Error
 }
 
 // GetAlreadyFetchedMatches returns which matches from the received array are already fetched.
-func (mr *matchRepository) GetAlreadyFetchedMatches(riotMatchIDs []string) ([]models.MatchInfo, error) {
+func (mr *matchRepository) GetAlreadyFetchedMatches(ctx context.Context, riotMatchIDs []string) ([]models.MatchInfo, error) {
 	const batchSize = 1000
 	var allMatches []models.MatchInfo
 
@@ -59,7 +63,7 @@ func (mr *matchRepository) GetAlreadyFetchedMatches(riotMatchIDs []string) ([]mo
 		end := min(i+batchSize, len(riotMatchIDs))
 
 		var batchMatches []models.MatchInfo
-		result := mr.db.Where("match_id IN (?)", riotMatchIDs[i:end]).Find(&batchMatches)
+		result := mr.db.WithContext(ctx).Where("match_id IN (?)", riotMatchIDs[i:end]).Find(&batchMatches)
 		if result.Error != nil {
 			return nil, result.Error
 		}
@@ -70,29 +74,76 @@ func (mr *matchRepository) GetAlreadyFetchedMatches(riotMatchIDs []string) ([]mo
 	return allMatches, nil
 }
 
+// GetMatchByMatchId returns the match with the given Riot match id.
+func (mr *matchRepository) GetMatchByMatchId(ctx context.Context, riotMatchID string) (*models.MatchInfo, error) {
+	var match models.MatchInfo
+	if err := mr.db.WithContext(ctx).Where("match_id = ?", riotMatchID).First(&match).Error; err != nil {
+		return nil, err
+	}
+
+	return &match, nil
+}
+
+// GetMatchForUpdate returns the match and locks it until the end of the transaction.
+// Used to serialize the timeline ingestions of the same match.
+func (mr *matchRepository) GetMatchForUpdate(ctx context.Context, matchID uint) (*models.MatchInfo, error) {
+	var match models.MatchInfo
+	if err := mr.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", matchID).
+		First(&match).Error; err != nil {
+		return nil, err
+	}
+
+	return &match, nil
+}
+
+// GetStatIdsByPuuid returns the stat id of each participant of a match, by PUUID.
+func (mr *matchRepository) GetStatIdsByPuuid(ctx context.Context, matchID uint) (map[string]uint64, error) {
+	var rows []struct {
+		ID    uint64
+		Puuid string
+	}
+
+	if err := mr.db.WithContext(ctx).Model(&models.MatchStats{}).
+		Select("match_stats.id, player_infos.puuid").
+		Joins("JOIN player_infos ON player_infos.id = match_stats.player_id").
+		Where("match_stats.match_id = ?", matchID).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	statIdByPuuid := make(map[string]uint64, len(rows))
+	for _, row := range rows {
+		statIdByPuuid[row.Puuid] = row.ID
+	}
+
+	return statIdByPuuid, nil
+}
+
 // SetAverageRating set the average rating for a given match, used for calculating tier data.
-func (mr *matchRepository) SetAverageRating(matchID uint, rating float64) error {
-	return mr.updateMatchField(matchID, "average_rating", rating)
+func (mr *matchRepository) SetAverageRating(ctx context.Context, matchID uint, rating float64) error {
+	return mr.updateMatchField(ctx, matchID, "average_rating", rating)
 }
 
 // SetFrameInterval set the frame interval for the match timeline.
-func (mr *matchRepository) SetFrameInterval(matchID uint, interval int64) error {
-	return mr.updateMatchField(matchID, "frame_interval", interval)
+func (mr *matchRepository) SetFrameInterval(ctx context.Context, matchID uint, interval int64) error {
+	return mr.updateMatchField(ctx, matchID, "frame_interval", interval)
 }
 
 // SetFullyFetched set the match as fetched, meaning it doesn't need to be fetched again.
-func (mr *matchRepository) SetFullyFetched(matchID uint) error {
-	return mr.updateMatchField(matchID, "fully_fetched", true)
+func (mr *matchRepository) SetFullyFetched(ctx context.Context, matchID uint) error {
+	return mr.updateMatchField(ctx, matchID, "fully_fetched", true)
 }
 
 // SetMatchWinner sets which team has won a given metch.
-func (mr *matchRepository) SetMatchWinner(matchID uint, winner int) error {
-	return mr.updateMatchField(matchID, "match_winner", winner)
+func (mr *matchRepository) SetMatchWinner(ctx context.Context, matchID uint, winner int) error {
+	return mr.updateMatchField(ctx, matchID, "match_winner", winner)
 }
 
 // updateMatchField is a generic update helper for a single field in MatchInfo.
-func (mr *matchRepository) updateMatchField(matchID uint, field string, value any) error {
-	return mr.db.Model(&models.MatchInfo{}).
+func (mr *matchRepository) updateMatchField(ctx context.Context, matchID uint, field string, value any) error {
+	return mr.db.WithContext(ctx).Model(&models.MatchInfo{}).
 		Where("id = ?", matchID).
 		Update(field, value).Error
 }
@@ -1,11 +1,12 @@
 package repositories
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"goleague/pkg/database/models"
 	"goleague/pkg/regions"
-	"log"
+	"log/slog"
 	"sort"
 	"strings"
 	"time"
@@ -16,14 +17,15 @@ import (
 
 // PlayerRepository defines the public interface for handling player related data.
 type PlayerRepository interface {
-	CreatePlayersBatch(players []*models.PlayerInfo) error
-	GetPlayerByNameTagRegion(gameName string, gameTag string, region string) (*models.PlayerInfo, error)
-	GetPlayerByPuuid(puuid string) (*models.PlayerInfo, error)
-	GetPlayersByPuuids(puuids []string) (map[string]*models.PlayerInfo, error)
-	GetNextFetchPlayerBySubRegion(subRegion regions.SubRegion) (*models.PlayerInfo, error)
-	SetDelayedLastFetch(playerId uint) error
-	SetFetched(playerId uint) error
-	UpsertPlayerBatch(players []*models.PlayerInfo) error
+	CreatePlayersBatch(ctx context.Context, players []*models.PlayerInfo) error
+	GetPlayerByNameTagRegion(ctx context.Context, gameName string, gameTag string, region string) (*models.PlayerInfo, error)
+	GetPlayerByPuuid(ctx context.Context, puuid string) (*models.PlayerInfo, error)
+	GetPlayersByPuuids(ctx context.Context, puuids []string) (map[string]*models.PlayerInfo, error)
+	GetFetchPriority(ctx context.Context, playerId uint) (int, error)
+	GetNextFetchPlayerBySubRegion(ctx context.Context, subRegion regions.SubRegion) (*models.PlayerInfo, error)
+	SetDelayedLastFetch(ctx context.Context, playerId uint) error
+	SetFetched(ctx context.Context, playerId uint) error
+	UpsertPlayerBatch(ctx context.Context, players []*models.PlayerInfo) error
 }
 
 // playerRepository is the repository instance.
@@ -37,19 +39,19 @@ func NewPlayerRepository(db *gorm.DB) (PlayerRepository, error) {
 }
 
 // CreatePlayersBatch creates multiple players in batches of 1000.
-func (ps *playerRepository) CreatePlayersBatch(players []*models.PlayerInfo) error {
+func (ps *playerRepository) CreatePlayersBatch(ctx context.Context, players []*models.PlayerInfo) error {
 	if len(players) == 0 {
 		return nil
 	}
 
 	// Creates in batches of 1000.
-	return ps.db.CreateInBatches(&players, 1000).Error
+	return ps.db.WithContext(ctx).CreateInBatches(&players, 1000).Error
 }
 
 // GetPlayerByNameTagRegion returns a given player by his gamename, tag and region.
-func (ps *playerRepository) GetPlayerByNameTagRegion(gameName string, gameTag string, region string) (*models.PlayerInfo, error) {
+func (ps *playerRepository) GetPlayerByNameTagRegion(ctx context.Context, gameName string, gameTag string, region string) (*models.PlayerInfo, error) {
 	var player models.PlayerInfo
-	if err := ps.db.
+	if err := ps.db.WithContext(ctx).
 		Where("riot_id_game_name = ? AND riot_id_tagline = ? AND region = ?", gameName, gameTag, region).
 		First(&player).Error; err != nil {
 
@@ -65,10 +67,10 @@ func (ps *playerRepository) GetPlayerByNameTagRegion(gameName string, gameTag st
 }
 
 // GetPlayerByPuuid returns a given player by his PUUID.
-func (ps *playerRepository) GetPlayerByPuuid(puuid string) (*models.PlayerInfo, error) {
+func (ps *playerRepository) GetPlayerByPuuid(ctx context.Context, puuid string) (*models.PlayerInfo, error) {
 	// Retrieve player by PUUID.
 	var player models.PlayerInfo
-	if err := ps.db.Where("puuid = ?", puuid).First(&player).Error; err != nil {
+	if err := ps.db.WithContext(ctx).Where("puuid = ?", puuid).First(&player).Error; err != nil {
 		// If the record was not found, doesn't need to return a error.
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -81,7 +83,7 @@ func (ps *playerRepository) GetPlayerByPuuid(puuid string) (*models.PlayerInfo,
 }
 
 // GetPlayersByPuuids returns a list of players by a list of passed PUUIDs.
-func (ps *playerRepository) GetPlayersByPuuids(puuids []string) (map[string]*models.PlayerInfo, error) {
+func (ps *playerRepository) GetPlayersByPuuids(ctx context.Context, puuids []string) (map[string]*models.PlayerInfo, error) {
 	// Empty list, just return nil.
 	if len(puuids) == 0 {
 		return nil, nil
@@ -89,7 +91,7 @@ func (ps *playerRepository) GetPlayersByPuuids(puuids []string) (map[string]*mod
 
 	// Get the players.
 	var players []models.PlayerInfo
-	result := ps.db.Where("puuid IN (?)", puuids).Find(&players)
+	result := ps.db.WithContext(ctx).Where("puuid IN (?)", puuids).Find(&players)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -103,10 +105,28 @@ func (ps *playerRepository) GetPlayersByPuuids(puuids []string) (map[string]*mod
 	return playersMap, nil
 }
 
+// GetFetchPriority returns the last calculated fetch priority of a player.
+// Players without a priority yet are treated as the lowest one.
+func (ps *playerRepository) GetFetchPriority(ctx context.Context, playerId uint) (int, error) {
+	var priorities []int
+	if err := ps.db.WithContext(ctx).Table("player_fetch_priorities").
+		Where("player_id = ?", playerId).
+		Limit(1).
+		Pluck("fetch_priority", &priorities).Error; err != nil {
+		return 0, err
+	}
+
+	if len(priorities) == 0 {
+		return 0, nil
+	}
+
+	return priorities[0], nil
+}
+
 // GetNextFetchPlayerBySubRegion returns a single player from a region, getting the next player with pending matches ordered by fetch priority.
-func (ps *playerRepository) GetNextFetchPlayerBySubRegion(subRegion regions.SubRegion) (*models.PlayerInfo, error) {
+func (ps *playerRepository) GetNextFetchPlayerBySubRegion(ctx context.Context, subRegion regions.SubRegion) (*models.PlayerInfo, error) {
 	var unfetchedPlayer models.PlayerInfo
-	result := ps.db.
+	result := ps.db.WithContext(ctx).
 		Joins("JOIN player_fetch_priorities pfp ON pfp.player_id = player_infos.id").
 		Where("player_infos.unfetched_match = ?", true).
 		Where("pfp.region = ?", subRegion).
@@ -120,9 +140,9 @@ func (ps *playerRepository) GetNextFetchPlayerBySubRegion(subRegion regions.SubR
 
 	// If no rows found (priorities table might be empty), use fallback.
 	if result.Error == gorm.ErrRecordNotFound {
-		log.Printf("No prioritized players found for region %s, using fallback query", subRegion)
+		slog.InfoContext(ctx, "No prioritized players found, using the fallback query", "region", subRegion)
 
-		fallbackResult := ps.db.
+		fallbackResult := ps.db.WithContext(ctx).
 			Where("player_infos.unfetched_match = ?", true).
 			Where("player_infos.region = ?", subRegion).
 			Order("player_infos.last_match_fetch ASC").
@@ -139,15 +159,15 @@ func (ps *playerRepository) GetNextFetchPlayerBySubRegion(subRegion regions.SubR
 }
 
 // SetDelayedLastFetch set the date of the last time fetch to the previous + 1 day.
-func (ps *playerRepository) SetDelayedLastFetch(playerId uint) error {
-	return ps.db.Model(&models.PlayerInfo{}).
+func (ps *playerRepository) SetDelayedLastFetch(ctx context.Context, playerId uint) error {
+	return ps.db.WithContext(ctx).Model(&models.PlayerInfo{}).
 		Where("id = ?", playerId).
 		UpdateColumn("last_match_fetch", gorm.Expr("last_match_fetch + interval '1 day'")).Error
 }
 
 // SetFetched set the player as fetched and store the date where it was fetched.
-func (ps *playerRepository) SetFetched(playerId uint) error {
-	return ps.db.Model(&models.PlayerInfo{}).
+func (ps *playerRepository) SetFetched(ctx context.Context, playerId uint) error {
+	return ps.db.WithContext(ctx).Model(&models.PlayerInfo{}).
 		Where("id = ?", playerId).
 		Updates(
 			map[string]any{
@@ -160,7 +180,7 @@ func (ps *playerRepository) SetFetched(playerId uint) error {
 // UpsertPlayerBatch upsert multiple players with retry.
 // The retry is due to the possibility of a deadlock.
 // The deadlock could be caused by the main region updating a given player or working with Goroutines for fetching matches.
-func (ps *playerRepository) UpsertPlayerBatch(players []*models.PlayerInfo) error {
+func (ps *playerRepository) UpsertPlayerBatch(ctx context.Context, players []*models.PlayerInfo) error {
 	const maxRetries = 3
 
 	// Sort to improve deadlock treatment.
@@ -172,7 +192,7 @@ func (ps *playerRepository) UpsertPlayerBatch(players []*models.PlayerInfo) erro
 	})
 
 	for range maxRetries {
-		err := ps.db.Clauses(clause.OnConflict{
+		err := ps.db.WithContext(ctx).Clauses(clause.OnConflict{
 			Columns: []clause.Column{{Name: "puuid"}, {Name: "region"}},
 			DoUpdates: clause.Assignments(map[string]any{
 				"profile_icon":      gorm.Expr("CASE WHEN player_infos.updated_at < excluded.updated_at THEN excluded.profile_icon ELSE player_infos.profile_icon END"),
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
 	leaguefetcher "goleague/fetcher/data/league"
 	"goleague/pkg/database/models"
@@ -12,9 +13,9 @@ import (
 
 // RatingRepository is the public interface for handling rating changes.
 type RatingRepository interface {
-	CreateBatchRating(entries []models.RatingEntry) error
-	GetAverageRatingOnMatchByPlayerId(ids []uint, matchID uint, matchTimestamp time.Time, queue string) float64
-	GetLastRatingEntryByPlayerIdsAndQueue(ids []uint, queue string) (map[uint]*models.RatingEntry, error)
+	CreateBatchRating(ctx context.Context, entries []models.RatingEntry) error
+	GetAverageRatingOnMatchByPlayerId(ctx context.Context, ids []uint, matchID uint, matchTimestamp time.Time, queue string) float64
+	GetLastRatingEntryByPlayerIdsAndQueue(ctx context.Context, ids []uint, queue string) (map[uint]*models.RatingEntry, error)
 	RatingNeedsUpdate(lastRating *models.RatingEntry, entry leaguefetcher.LeagueEntry) bool
 }
 
@@ -30,17 +31,18 @@ func NewRatingRepository(db *gorm.DB) (RatingRepository, error) {
 
 // CreateBatchRating creates multiple rating entries at a time.
 func (rs *ratingRepository) CreateBatchRating(
+	ctx context.Context,
 	entries []models.RatingEntry,
 ) error {
 	if len(entries) == 0 {
 		return nil
 	}
 
-	return rs.db.CreateInBatches(&entries, 1000).Error
+	return rs.db.WithContext(ctx).CreateInBatches(&entries, 1000).Error
 }
 
 // GetAverageRatingOnMatchByPlayerId gets the average rating of the match.
-func (rs *ratingRepository) GetAverageRatingOnMatchByPlayerId(ids []uint, matchID uint, matchTimestamp time.Time, queue string) float64 {
+func (rs *ratingRepository) GetAverageRatingOnMatchByPlayerId(ctx context.Context, ids []uint, matchID uint, matchTimestamp time.Time, queue string) float64 {
 
 	// Build placeholders for the clause.
 	placeholders := strings.TrimRight(strings.Repeat("?,", len(ids)), ",")
@@ -68,13 +70,13 @@ func (rs *ratingRepository) GetAverageRatingOnMatchByPlayerId(ids []uint, matchI
 	}
 
 	var results EntryResult
-	rs.db.Raw(query, args...).Scan(&results)
+	rs.db.WithContext(ctx).Raw(query, args...).Scan(&results)
 
 	return results.AvgScore
 }
 
 // GetLastRatingEntryByPlayerIdsAndQueue returns a map of ratings by the playerID.
-func (rs *ratingRepository) GetLastRatingEntryByPlayerIdsAndQueue(ids []uint, queue string) (map[uint]*models.RatingEntry, error) {
+func (rs *ratingRepository) GetLastRatingEntryByPlayerIdsAndQueue(ctx context.Context, ids []uint, queue string) (map[uint]*models.RatingEntry, error) {
 
 	// Empty list, just return nil.
 	if len(ids) == 0 {
@@ -83,7 +85,7 @@ func (rs *ratingRepository) GetLastRatingEntryByPlayerIdsAndQueue(ids []uint, qu
 
 	// Get the ratings.
 	var ratings []models.RatingEntry
-	result := rs.db.Raw(`
+	result := rs.db.WithContext(ctx).Raw(`
         SELECT DISTINCT ON (player_id) * 
         FROM rating_entries
         WHERE player_id IN (?)
@@ -1,14 +1,33 @@
 package repositories
 
 import (
+	"context"
+	"goleague/pkg/database"
 	"goleague/pkg/database/models"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // TimelineRepository is the public interface for handling timeline data.
 type TimelineRepository interface {
-	CreateBatchParticipantFrame(frames []*models.ParticipantFrame) error
+	CreateBatchParticipantFrame(ctx context.Context, frames []*models.ParticipantFrame) error
+	CreatePendingTimeline(ctx context.Context, pending *models.PendingTimeline) error
+	DeleteMatchTimeline(ctx context.Context, matchID uint, matchStart time.Time) error
+	DeletePendingTimeline(ctx context.Context, matchID uint) error
+}
+
+// timelineEventModels are the event tables attached to a match.
+var timelineEventModels = []any{
+	&models.EventFeatUpdate{},
+	&models.EventItem{},
+	&models.EventKillStruct{},
+	&models.EventLevelUp{},
+	&models.EventMonsterKill{},
+	&models.EventPlayerKill{},
+	&models.EventSkillLevelUp{},
+	&models.EventWard{},
 }
 
 // timelineRepository is the repository instance.
@@ -21,18 +40,47 @@ func NewTimelineRepository(db *gorm.DB) (TimelineRepository, error) {
 	return &timelineRepository{db: db}, nil
 }
 
-// CreateBatchParticipantFrame creates the participant frames in batches of 1000.
-func (ts *timelineRepository) CreateBatchParticipantFrame(frames []*models.ParticipantFrame) error {
-	if len(frames) == 0 {
-		return nil
+// CreateBatchParticipantFrame creates the participant frames.
+// Uses COPY inside a copy transaction, else inserts in batches of 1000.
+func (ts *timelineRepository) CreateBatchParticipantFrame(ctx context.Context, frames []*models.ParticipantFrame) error {
+	return database.CopyFrom(ts.db.WithContext(ctx), frames)
+}
+
+// CreatePendingTimeline queues the timeline of a match to be fetched later.
+func (ts *timelineRepository) CreatePendingTimeline(ctx context.Context, pending *models.PendingTimeline) error {
+	return ts.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(pending).Error
+}
+
+// DeletePendingTimeline removes a match from the deferred timelines.
+// Inside a transaction, it also locks the entry until the timeline is saved.
+func (ts *timelineRepository) DeletePendingTimeline(ctx context.Context, matchID uint) error {
+	return ts.db.WithContext(ctx).Where("match_id = ?", matchID).Delete(&models.PendingTimeline{}).Error
+}
+
+// DeleteMatchTimeline removes the frames and events of a match.
+// Used before inserting a timeline, so a retry doesn't duplicate the events.
+// The match start restricts each delete to the partition of the match.
+func (ts *timelineRepository) DeleteMatchTimeline(ctx context.Context, matchID uint, matchStart time.Time) error {
+	statIds := ts.db.WithContext(ctx).Model(&models.MatchStats{}).
+		Select("id").
+		Where("match_id = ? AND match_start = ?", matchID, matchStart)
+
+	if err := ts.db.WithContext(ctx).
+		Where("match_stat_id IN (?) AND match_start = ?", statIds, matchStart).
+		Delete(&models.ParticipantFrame{}).Error; err != nil {
+		return err
+	}
+
+	for _, model := range timelineEventModels {
+		if err := ts.db.WithContext(ctx).Where("match_id = ? AND match_start = ?", matchID, matchStart).Delete(model).Error; err != nil {
+			return err
+		}
 	}
-	return ts.db.CreateInBatches(&frames, 1000).Error
+
+	return nil
 }
 
-// CreateEventBatch is a generic function for creating the events in batches.
+// CreateEventBatch is a generic function for creating the events in bulk.
 func CreateEventBatch[T any](db *gorm.DB, entities []*T) error {
-	if len(entities) == 0 {
-		return nil
-	}
-	return db.CreateInBatches(&entities, 1000).Error
+	return database.CopyFrom(db, entities)
 }
@@ -0,0 +1,163 @@
+package repositories
+
+import (
+	"context"
+	"goleague/internal/testutil"
+	"goleague/pkg/database"
+	"goleague/pkg/database/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+// newTestTimeline returns frames and events of a match, with the nullable columns both set and unset.
+func newTestTimeline(matchInfo *models.MatchInfo, statIds []uint64) ([]*models.ParticipantFrame, []*models.EventItem, []*models.EventWard) {
+	var frames []*models.ParticipantFrame
+	for i, statId := range statIds {
+		for frame := range 3 {
+			frames = append(frames, &models.ParticipantFrame{
+				MatchStatId:   statId,
+				FrameIndex:    frame,
+				MatchStart:    matchInfo.MatchStart,
+				CurrentGold:   frame * 100,
+				ParticipantId: i + 1,
+				TotalGold:     frame * 400,
+				XP:            frame * 350,
+			})
+		}
+	}
+
+	participantId := 1
+	afterId := 3340
+	items := []*models.EventItem{
+		{
+			EventBase: models.EventBase{MatchID: matchInfo.ID, MatchStart: matchInfo.MatchStart, ParticipantID: &participantId, Timestamp: 1000},
+			ItemId:    1055,
+			Action:    "ITEM_PURCHASED",
+		},
+		{
+			EventBase: models.EventBase{MatchID: matchInfo.ID, MatchStart: matchInfo.MatchStart, ParticipantID: &participantId, Timestamp: 2000},
+			ItemId:    3364,
+			AfterId:   &afterId,
+			Action:    "ITEM_UNDO",
+		},
+	}
+
+	wardType := "YELLOW_TRINKET"
+	wards := []*models.EventWard{
+		{
+			EventBase: models.EventBase{MatchID: matchInfo.ID, MatchStart: matchInfo.MatchStart, ParticipantID: &participantId, Timestamp: 3000},
+			EventType: "WARD_PLACED",
+			WardType:  &wardType,
+		},
+		{
+			EventBase: models.EventBase{MatchID: matchInfo.ID, MatchStart: matchInfo.MatchStart, Timestamp: 4000},
+			EventType: "WARD_KILL",
+		},
+	}
+
+	return frames, items, wards
+}
+
+// readTestTimeline returns the stored frames and events of a match, without the ids of the match.
+func readTestTimeline(t *testing.T, db *gorm.DB, matchInfo *models.MatchInfo, statIds []uint64) ([]models.ParticipantFrame, []models.EventItem, []models.EventWard) {
+	t.Helper()
+
+	var frames []models.ParticipantFrame
+	assert.NoError(t, db.Where("match_stat_id IN ?", statIds).Order("match_stat_id, frame_index").Find(&frames).Error)
+	for i := range frames {
+		frames[i].MatchStatId = 0
+	}
+
+	var items []models.EventItem
+	assert.NoError(t, db.Where("match_id = ?", matchInfo.ID).Order("timestamp").Find(&items).Error)
+	for i := range items {
+		items[i].MatchID = 0
+	}
+
+	var wards []models.EventWard
+	assert.NoError(t, db.Where("match_id = ?", matchInfo.ID).Order("timestamp").Find(&wards).Error)
+	for i := range wards {
+		wards[i].MatchID = 0
+	}
+
+	return frames, items, wards
+}
+
+func TestCopyTransactionTimeline(t *testing.T) {
+	db, cleanup := testutil.NewTestConnection(t)
+	defer cleanup()
+
+	// Not in UTC, the stored match start must be the same instant.
+	matchStart := time.Now().Truncate(time.Microsecond).In(time.FixedZone("BRT", -3*60*60))
+	copyMatch, copyStatIds := seedTimelineMatch(t, db, "COPY_TIMELINE", matchStart)
+	batchMatch, batchStatIds := seedTimelineMatch(t, db, "BATCH_TIMELINE", matchStart)
+
+	// The COPY path doesn't go through the create callbacks, only the batched inserts do.
+	creates := 0
+	err := db.Callback().Create().Before("gorm:create").Register("test:count_creates", func(*gorm.DB) {
+		creates++
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	insert := func(tx *gorm.DB, matchInfo *models.MatchInfo, statIds []uint64) error {
+		frames, items, wards := newTestTimeline(matchInfo, statIds)
+
+		// A new context on the session must keep the transaction of the pinned connection.
+		ctx := context.Background()
+		repository, err := NewTimelineRepository(tx.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		if err := repository.CreateBatchParticipantFrame(ctx, frames); err != nil {
+			return err
+		}
+		if err := CreateEventBatch(tx.WithContext(ctx), items); err != nil {
+			return err
+		}
+		return CreateEventBatch(tx.WithContext(ctx), wards)
+	}
+
+	err = database.CopyTransaction(context.Background(), db, func(tx *gorm.DB) error {
+		return insert(tx, copyMatch, copyStatIds)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Zero(t, creates, "the copy transaction fell back to the batched inserts")
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		return insert(tx, batchMatch, batchStatIds)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotZero(t, creates)
+
+	copyFrames, copyItems, copyWards := readTestTimeline(t, db, copyMatch, copyStatIds)
+	batchFrames, batchItems, batchWards := readTestTimeline(t, db, batchMatch, batchStatIds)
+
+	assert.Len(t, copyFrames, len(copyStatIds)*3)
+	assert.Equal(t, batchFrames, copyFrames)
+	assert.Equal(t, batchItems, copyItems)
+	assert.Equal(t, batchWards, copyWards)
+
+	if assert.Len(t, copyItems, 2) {
+		assert.True(t, copyItems[0].MatchStart.Equal(matchStart))
+		assert.Nil(t, copyItems[0].AfterId)
+		if assert.NotNil(t, copyItems[1].AfterId) {
+			assert.Equal(t, 3340, *copyItems[1].AfterId)
+		}
+	}
+
+	if !assert.Len(t, copyWards, 2) {
+		return
+	}
+	assert.Nil(t, copyWards[1].ParticipantID)
+	assert.Nil(t, copyWards[1].WardType)
+}
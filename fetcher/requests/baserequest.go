@@ -1,17 +1,36 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"goleague/pkg/messages"
-	"log"
+	"goleague/pkg/metrics"
+	"goleague/pkg/tracing"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Riot endpoints, used to label the request metrics without the variable parts of the URL.
+const (
+	EndpointAccountByRiotId    = "account-v1.by-riot-id"
+	EndpointLeagueEntries      = "league-exp-v4.entries"
+	EndpointLeagueEntriesPuuid = "league-v4.entries-by-puuid"
+	EndpointMatch              = "match-v5.matches"
+	EndpointMatchListByPuuid   = "match-v5.by-puuid"
+	EndpointMatchTimeline      = "match-v5.timeline"
+	EndpointSummonerByPuuid    = "summoner-v4.by-puuid"
 )
 
 // AuthRequest make a authenticated request to the Riot API.
 // Return the respose.
-func AuthRequest(apiKey string, uri string, method string, params map[string]string) (*http.Response, error) {
+func AuthRequest(ctx context.Context, apiKey string, uri string, method string, params map[string]string) (*http.Response, error) {
 	// Parse the URL.
 	u, err := url.Parse(uri)
 	if err != nil {
@@ -26,9 +45,9 @@ func AuthRequest(apiKey string, uri string, method string, params map[string]str
 	u.RawQuery = query.Encode()
 
 	// Create the request for the given url.
-	req, err := http.NewRequest(method, u.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
 	if err != nil {
-		log.Println("Error creating request:", err)
+		slog.Error("Couldn't create the request", "error", err)
 		return nil, err
 	}
 
@@ -42,7 +61,7 @@ func AuthRequest(apiKey string, uri string, method string, params map[string]str
 func Request(url string, method string) (*http.Response, error) {
 	req, err := http.NewRequest(method, url, nil)
 	if err != nil {
-		log.Println("Error creating request:", err)
+		slog.Error("Couldn't create the request", "error", err)
 		return nil, err
 	}
 	client := &http.Client{}
@@ -50,13 +69,28 @@ func Request(url string, method string) (*http.Response, error) {
 }
 
 // HandleAuthRequest works with generics to abstract the decoding process.
-func HandleAuthRequest[T any](apiKey string, url string, method string, params map[string]string) (T, error) {
+func HandleAuthRequest[T any](ctx context.Context, apiKey string, endpoint string, url string, method string, params map[string]string) (respData T, err error) {
+	ctx, span := tracing.StartSpan(ctx, "riot.request",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("riot.endpoint", endpoint),
+			attribute.String("http.request.method", method),
+		),
+	)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var zero T
-	resp, err := AuthRequest(apiKey, url, method, params)
+	start := time.Now()
+	resp, err := AuthRequest(ctx, apiKey, url, method, params)
+	metrics.ObserveSince(metrics.RiotRequestDuration.WithLabelValues(endpoint), start)
 	if err != nil {
+		metrics.RiotRequestsTotal.WithLabelValues(endpoint, "error").Inc()
 		return zero, fmt.Errorf(messages.RequestFailedMsg+": %w", url, err)
 	}
 
+	metrics.RiotRequestsTotal.WithLabelValues(endpoint, strconv.Itoa(resp.StatusCode)).Inc()
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+
 	defer resp.Body.Close()
 
 	// Check the status code.
@@ -65,7 +99,6 @@ func HandleAuthRequest[T any](apiKey string, url string, method string, params m
 	}
 
 	// Parse the match timeline.
-	var respData T
 	if err := json.NewDecoder(resp.Body).Decode(&respData); err != nil {
 		return zero, fmt.Errorf(messages.FailedToParseMsg+": %w", err)
 	}
@@ -1,7 +1,13 @@
 package requests
 
 import (
+	"context"
 	"goleague/pkg/config"
+	"goleague/pkg/metrics"
+	"goleague/pkg/tracing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/Gustavo-Feijo/gomultirate"
 )
@@ -22,3 +28,27 @@ func NewRateLimiter(config config.RiotLimiterConfig) *gomultirate.RateLimiter {
 	limiter, _ := gomultirate.NewRateLimiter(limits)
 	return limiter
 }
+
+// WaitLimiter waits for the limiter and records the time spent waiting.
+// On demand requests use any available slot, while the jobs are spread evenly.
+func WaitLimiter(ctx context.Context, limiter *gomultirate.RateLimiter, region string, onDemand bool) {
+	limit := "job"
+	if onDemand {
+		limit = "api"
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "riot.limiter.wait")
+	span.SetAttributes(
+		attribute.String("riot.region", region),
+		attribute.String("riot.limit", limit),
+	)
+	defer span.End()
+
+	start := time.Now()
+	if onDemand {
+		limiter.Wait(ctx)
+	} else {
+		limiter.WaitEvenly(ctx, limit)
+	}
+	metrics.ObserveSince(metrics.RiotLimiterWait.WithLabelValues(region, limit), start)
+}
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"goleague/fetcher/repositories"
 	"goleague/pkg/database/models"
-	"log"
+	"log/slog"
 	"sync"
 
 	"gorm.io/gorm"
@@ -116,10 +116,10 @@ func processBatchEvents[T any](db *gorm.DB, events []any, eventType string, errs
 	}
 
 	if invalidCount > 0 {
-		log.Printf("Warning: %d invalid events found for %s", invalidCount, eventType)
+		slog.Warn("Invalid events found", "event_type", eventType, "count", invalidCount)
 	}
 	if err := repositories.CreateEventBatch(db, modelList); err != nil {
-		log.Printf("Error inserting event %s: %v", eventType, err)
+		slog.Error("Couldn't insert the events", "event_type", eventType, "error", err)
 		*errs = append(*errs, err)
 	}
 }
@@ -1,6 +1,7 @@
 package eventservice
 
 import (
+	"context"
 	"errors"
 	matchfetcher "goleague/fetcher/data/match"
 	"goleague/fetcher/repositories"
@@ -43,6 +44,7 @@ func (es *EventService) prepareChampionKill(
 	eventInsert := &models.EventPlayerKill{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.KillerId,
 			Timestamp:     event.Timestamp,
 		},
@@ -58,6 +60,7 @@ func (es *EventService) prepareChampionKill(
 // Handle the events as any/interface{}.
 // Add each event to the batch collector for further batch insertion.
 func (es *EventService) PrepareEvents(
+	ctx context.Context,
 	event matchfetcher.EventFrame,
 	matchInfo *models.MatchInfo,
 	batchCollector *batchservice.BatchCollector,
@@ -96,7 +99,7 @@ func (es *EventService) PrepareEvents(
 		eventData, err = es.prepareMonsterKill(event, matchInfo)
 
 	case "GAME_END":
-		err = es.setMatchWinner(event, matchInfo)
+		err = es.setMatchWinner(ctx, event, matchInfo)
 
 	}
 
@@ -144,11 +147,12 @@ func (es *EventService) prepareFeatUpdateEvent(
 	}
 
 	eventInsert := &models.EventFeatUpdate{
-		MatchID:   matchInfo.ID,
-		Timestamp: event.Timestamp,
-		FeatType:  featType,
-		FeatValue: featValue,
-		TeamId:    teamId,
+		MatchID:    matchInfo.ID,
+		MatchStart: matchInfo.MatchStart,
+		Timestamp:  event.Timestamp,
+		FeatType:   featType,
+		FeatValue:  featValue,
+		TeamId:     teamId,
 	}
 
 	return eventInsert, nil
@@ -172,6 +176,7 @@ func (es *EventService) prepareItemEvent(
 	eventInsert := &models.EventItem{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.ParticipantId,
 			Timestamp:     event.Timestamp,
 		},
@@ -204,6 +209,7 @@ func (es *EventService) prepareLevelUpEvent(
 	eventInsert := &models.EventLevelUp{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.ParticipantId,
 			Timestamp:     event.Timestamp,
 		},
@@ -246,6 +252,7 @@ func (es *EventService) prepareMonsterKill(
 	eventInsert := &models.EventMonsterKill{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.KillerId,
 			Timestamp:     event.Timestamp,
 		},
@@ -274,6 +281,7 @@ func (es *EventService) prepareSkillLevelUpEvent(
 	eventInsert := &models.EventSkillLevelUp{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.ParticipantId,
 			Timestamp:     event.Timestamp,
 		},
@@ -312,6 +320,7 @@ func (es *EventService) prepareStructKillEvent(
 	eventInsert := &models.EventKillStruct{
 		EventBase: models.EventBase{
 			MatchID:       matchInfo.ID,
+			MatchStart:    matchInfo.MatchStart,
 			ParticipantID: event.KillerId,
 			Timestamp:     event.Timestamp,
 		},
@@ -348,6 +357,7 @@ func (es *EventService) prepareWardEvent(
 		eventInsert := &models.EventWard{
 			EventBase: models.EventBase{
 				MatchID:       matchInfo.ID,
+				MatchStart:    matchInfo.MatchStart,
 				ParticipantID: actorIdPtr,
 				Timestamp:     event.Timestamp,
 			},
@@ -364,6 +374,7 @@ func (es *EventService) prepareWardEvent(
 
 // setMatchWinner set the match winner.
 func (es *EventService) setMatchWinner(
+	ctx context.Context,
 	event matchfetcher.EventFrame,
 	matchInfo *models.MatchInfo,
 ) error {
@@ -372,5 +383,5 @@ func (es *EventService) setMatchWinner(
 		teamId = *event.WinningTeam
 	}
 
-	return es.MatchRepository.SetMatchWinner(matchInfo.ID, teamId)
+	return es.MatchRepository.SetMatchWinner(ctx, matchInfo.ID, teamId)
 }
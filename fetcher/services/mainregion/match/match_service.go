@@ -1,6 +1,7 @@
 package matchservice
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"goleague/fetcher/data"
@@ -9,8 +10,11 @@ import (
 	playerservice "goleague/fetcher/services/mainregion/player"
 	"goleague/pkg/database/models"
 	"goleague/pkg/regions"
+	patchvalues "goleague/pkg/riotvalues/patch"
 
 	"time"
+
+	"gorm.io/gorm"
 )
 
 // MatchService handles functionality related to matches.
@@ -45,14 +49,44 @@ func NewMatchService(
 	}
 }
 
+// WithTx returns a copy of the service with every repository bound to a transaction.
+func (m *MatchService) WithTx(tx *gorm.DB) (*MatchService, error) {
+	matchRepo, err := repositories.NewMatchRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	playerRepo, err := repositories.NewPlayerRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	ratingRepo, err := repositories.NewRatingRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	timelineRepo, err := repositories.NewTimelineRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	playerService, err := m.playerService.WithTx(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMatchService(m.fetcher, matchRepo, playerRepo, ratingRepo, timelineRepo, playerService, m.maxRetries), nil
+}
+
 // GetMatchData gets the data of the match from the Riot API.
-func (m *MatchService) GetMatchData(matchId string, onDemand bool) (*matchfetcher.MatchData, error) {
+func (m *MatchService) GetMatchData(ctx context.Context, matchId string, onDemand bool) (*matchfetcher.MatchData, error) {
 	var matchData *matchfetcher.MatchData
 	var err error
 
 	for attempt := 1; attempt < m.maxRetries; attempt++ {
 		// Get the match data.
-		matchData, err = m.fetcher.Match.GetMatchData(matchId, onDemand)
+		matchData, err = m.fetcher.Match.GetMatchData(ctx, matchId, onDemand)
 
 		// Everything went right, just continue normally.
 		if err == nil {
@@ -73,13 +107,16 @@ func (m *MatchService) GetMatchData(matchId string, onDemand bool) (*matchfetche
 
 // ProcessMatchInfo retrieves the match info and inserts it into the database.
 func (m *MatchService) ProcessMatchInfo(
+	ctx context.Context,
 	match *matchfetcher.MatchData,
 	matchId string,
 ) (*models.MatchInfo, error) {
 	// Create a match to be inserted.
 	matchInfo := &models.MatchInfo{
 		GameVersion:    match.Info.GameVersion,
+		Patch:          patchvalues.FromGameVersion(match.Info.GameVersion),
 		MatchId:        matchId,
+		Region:         match.Info.PlatformId,
 		MatchStart:     match.Info.GameCreation.Time(),
 		MatchDuration:  match.Info.GameDuration,
 		MatchSurrender: match.Info.Participants[0].GameEndedInSurrender,
@@ -89,11 +126,12 @@ func (m *MatchService) ProcessMatchInfo(
 
 	// Create the match.
 	// Return the match that we tried to insert and the error result of the insert (Nil or error).
-	return matchInfo, m.MatchRepository.CreateMatchInfo(matchInfo)
+	return matchInfo, m.MatchRepository.CreateMatchInfo(ctx, matchInfo)
 }
 
 // ProcessMatchBans retrieves the bans and creates them.
 func (m *MatchService) ProcessMatchBans(
+	ctx context.Context,
 	matchTeams []matchfetcher.TeamInfo,
 	matchInfo *models.MatchInfo,
 ) ([]*models.MatchBans, error) {
@@ -114,7 +152,7 @@ func (m *MatchService) ProcessMatchBans(
 	// Some modes don't have bans.
 	if len(bans) != 0 {
 		// Create the bans.
-		if err := m.MatchRepository.CreateMatchBans(bans); err != nil {
+		if err := m.MatchRepository.CreateMatchBans(ctx, bans); err != nil {
 			return nil, err
 		}
 	}
@@ -122,32 +160,47 @@ func (m *MatchService) ProcessMatchBans(
 	return bans, nil
 }
 
+// UpsertMatchPlayers upserts the players of the match.
+// Runs before the match transaction, the players are shared with every other match being processed.
+func (m *MatchService) UpsertMatchPlayers(
+	ctx context.Context,
+	match *matchfetcher.MatchData,
+	matchId string,
+	region regions.SubRegion,
+) ([]*models.PlayerInfo, map[string]matchfetcher.MatchPlayer, error) {
+	players, participantByPuuid, err := m.playerService.UpsertPlayersFromMatch(ctx, match.Info.Participants, matchId, match.Info.GameCreation.Time(), region)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't create the players for the match %s: %v", matchId, err)
+	}
+
+	return players, participantByPuuid, nil
+}
+
 // ProcessMatchData processes the match data and inserts it into the database.
+// The players must be already upserted with UpsertMatchPlayers.
 func (m *MatchService) ProcessMatchData(
+	ctx context.Context,
 	match *matchfetcher.MatchData,
 	matchId string,
-	region regions.SubRegion,
+	players []*models.PlayerInfo,
+	participantByPuuid map[string]matchfetcher.MatchPlayer,
 ) (*models.MatchInfo, []*models.MatchBans, []*models.MatchStats, error) {
 	// Process the match infos.
-	matchInfo, err := m.ProcessMatchInfo(match, matchId)
+	matchInfo, err := m.ProcessMatchInfo(ctx, match, matchId)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("couldn't create the match info for the match %s: %v", matchId, err)
 	}
 
 	// Process the bans.
-	bans, err := m.ProcessMatchBans(match.Info.Teams, matchInfo)
+	bans, err := m.ProcessMatchBans(ctx, match.Info.Teams, matchInfo)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("couldn't create the bans for the match %s: %v", matchInfo.MatchId, err)
 	}
 
-	// Process each player.
-	playersToUpsert, participantByPuuid, err := m.playerService.ProcessPlayersFromMatch(match.Info.Participants, matchInfo, region)
-	if err != nil {
-		return nil, nil, nil, fmt.Errorf("couldn't create the players for the match %s: %v", matchInfo.MatchId, err)
-	}
+	m.playerService.SetMatchAverageRating(ctx, players, matchInfo)
 
 	// Process the match stats.
-	stats, err := m.ProcessMatchStats(playersToUpsert, participantByPuuid, matchInfo)
+	stats, err := m.ProcessMatchStats(ctx, players, participantByPuuid, matchInfo)
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("couldn't create the stats for the match %s: %v", matchInfo.MatchId, err)
 	}
@@ -157,6 +210,7 @@ func (m *MatchService) ProcessMatchData(
 
 // ProcessMatchStats procesesses and inserts match stats for each player.
 func (m *MatchService) ProcessMatchStats(
+	ctx context.Context,
 	playersToUpsert []*models.PlayerInfo,
 	participants map[string]matchfetcher.MatchPlayer,
 	matchInfo *models.MatchInfo,
@@ -171,8 +225,9 @@ func (m *MatchService) ProcessMatchStats(
 
 		// Create the match stats.
 		newStat := &models.MatchStats{
-			MatchId:  matchInfo.ID,
-			PlayerId: player.ID,
+			MatchId:    matchInfo.ID,
+			PlayerId:   player.ID,
+			MatchStart: matchInfo.MatchStart,
 			PlayerData: models.MatchPlayer{
 				AllInPings:                     participant.AllInPings,
 				AssistMePing:                   participant.AssistMePing,
@@ -236,7 +291,7 @@ func (m *MatchService) ProcessMatchStats(
 	}
 
 	// Create/update the players.
-	if err := m.MatchRepository.CreateMatchStats(statsToUpsert); err != nil {
+	if err := m.MatchRepository.CreateMatchStats(ctx, statsToUpsert); err != nil {
 		return nil, err
 	}
 
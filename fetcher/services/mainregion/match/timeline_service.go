@@ -1,6 +1,7 @@
 package matchservice
 
 import (
+	"context"
 	"fmt"
 	"goleague/fetcher/data"
 	matchfetcher "goleague/fetcher/data/match"
@@ -9,7 +10,7 @@ import (
 	eventservice "goleague/fetcher/services/mainregion/events"
 	"goleague/pkg/database/models"
 
-	"log"
+	"log/slog"
 	"strconv"
 	"time"
 
@@ -39,14 +40,24 @@ func NewTimelineService(
 	}
 }
 
+// WithTx returns a copy of the service bound to a transaction.
+func (t *TimelineService) WithTx(tx *gorm.DB) (*TimelineService, error) {
+	timelineRepo, err := repositories.NewTimelineRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTimelineService(tx, t.fetcher, timelineRepo, t.maxRetries), nil
+}
+
 // GetMatchTimeline gets the timeline data for a match.
-func (t *TimelineService) GetMatchTimeline(matchId string, onDemand bool) (*matchfetcher.MatchTimeline, error) {
+func (t *TimelineService) GetMatchTimeline(ctx context.Context, matchId string, onDemand bool) (*matchfetcher.MatchTimeline, error) {
 	var matchData *matchfetcher.MatchTimeline
 	var err error
 
 	for attempt := 1; attempt < t.maxRetries; attempt++ {
 		// Get the match timeline.
-		matchData, err = t.fetcher.Match.GetMatchTimelineData(matchId, onDemand)
+		matchData, err = t.fetcher.Match.GetMatchTimelineData(ctx, matchId, onDemand)
 
 		// Everything went right, just continue normally..
 		if err == nil {
@@ -67,6 +78,7 @@ func (t *TimelineService) GetMatchTimeline(matchId string, onDemand bool) (*matc
 
 // ProcessMatchTimeline processes the match timeline data and inserts it into the database.
 func (t *TimelineService) ProcessMatchTimeline(
+	ctx context.Context,
 	matchTimeline *matchfetcher.MatchTimeline,
 	statIdByPuuid map[string]uint64,
 	matchInfo *models.MatchInfo,
@@ -81,7 +93,7 @@ func (t *TimelineService) ProcessMatchTimeline(
 
 	// Get the default frame interval.
 	frameInterval := matchTimeline.Info.FrameInterval
-	if err := matchRepo.SetFrameInterval(matchInfo.ID, frameInterval); err != nil {
+	if err := matchRepo.SetFrameInterval(ctx, matchInfo.ID, frameInterval); err != nil {
 		return fmt.Errorf("couldn't save the frame interval: %v", err)
 	}
 
@@ -97,7 +109,7 @@ func (t *TimelineService) ProcessMatchTimeline(
 			matchStatId := statIdByParticipantId[participantId]
 
 			// Append the participant frame to the list to batch insert.
-			framesToInsert = append(framesToInsert, t.prepareParticipantsFrames(frameData, matchStatId, frameIndex))
+			framesToInsert = append(framesToInsert, t.prepareParticipantsFrames(frameData, matchStatId, matchInfo.MatchStart, frameIndex))
 		}
 
 		// Process event handler service.
@@ -105,15 +117,20 @@ func (t *TimelineService) ProcessMatchTimeline(
 
 		// Loop through each event frame available.
 		for _, event := range frame.Event {
-			if err := eventService.PrepareEvents(event, matchInfo, eventCollector); err != nil {
-				// Don't need to add to the logger, usually associated with monsters not being killed before the next one spawns.
-				log.Printf("Couldn't insert event %s on timestamp %d on match %s: %v", event.Type, event.Timestamp, matchInfo.MatchId, err)
+			if err := eventService.PrepareEvents(ctx, event, matchInfo, eventCollector); err != nil {
+				// Only a warning, usually associated with monsters not being killed before the next one spawns.
+				slog.WarnContext(ctx, "Couldn't insert the event",
+					"event_type", event.Type,
+					"timestamp", event.Timestamp,
+					"match_id", matchInfo.MatchId,
+					"error", err,
+				)
 			}
 		}
 	}
 
 	// Insert the participant frames in a batch.
-	if err := t.TimelineRepository.CreateBatchParticipantFrame(framesToInsert); err != nil {
+	if err := t.TimelineRepository.CreateBatchParticipantFrame(ctx, framesToInsert); err != nil {
 		return fmt.Errorf("couldn't insert the participant frames on match %s: %v", matchInfo.MatchId, err)
 	}
 
@@ -127,11 +144,13 @@ func (t *TimelineService) ProcessMatchTimeline(
 func (t *TimelineService) prepareParticipantsFrames(
 	frame matchfetcher.ParticipantFrame,
 	matchStatId uint64,
+	matchStart time.Time,
 	frameId int,
 ) *models.ParticipantFrame {
 	// Create the participant to be inserted in the database.
 	participant := &models.ParticipantFrame{
 		MatchStatId: matchStatId,
+		MatchStart:  matchStart,
 		FrameIndex:  frameId,
 
 		CurrentGold:                   frame.CurrentGold,
@@ -1,6 +1,7 @@
 package playerservice
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	matchfetcher "goleague/fetcher/data/match"
@@ -8,8 +9,11 @@ import (
 	"goleague/pkg/database/models"
 	"goleague/pkg/regions"
 	queuevalues "goleague/pkg/riotvalues/queue"
-	"log"
+	"log/slog"
 	"sync"
+	"time"
+
+	"gorm.io/gorm"
 )
 
 // PlayerService is a separated service for player operations.
@@ -17,7 +21,7 @@ type PlayerService struct {
 	MatchRepository  repositories.MatchRepository
 	PlayerRepository repositories.PlayerRepository
 	RatingRepository repositories.RatingRepository
-	upsertMu         sync.Mutex
+	upsertMu         *sync.Mutex
 }
 
 // NewPlayerService creates a new player service.
@@ -30,16 +34,44 @@ func NewPlayerService(
 		MatchRepository:  matchRepo,
 		PlayerRepository: playerRepo,
 		RatingRepository: ratingRepo,
+		upsertMu:         &sync.Mutex{},
+	}
+}
+
+// WithTx returns a copy of the service bound to a transaction.
+// The players must not be upserted with the copy, the rows would stay locked after the upsert lock is released.
+func (p *PlayerService) WithTx(tx *gorm.DB) (*PlayerService, error) {
+	matchRepo, err := repositories.NewMatchRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	playerRepo, err := repositories.NewPlayerRepository(tx)
+	if err != nil {
+		return nil, err
 	}
+
+	ratingRepo, err := repositories.NewRatingRepository(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PlayerService{
+		MatchRepository:  matchRepo,
+		PlayerRepository: playerRepo,
+		RatingRepository: ratingRepo,
+		upsertMu:         p.upsertMu,
+	}, nil
 }
 
 // GetPlayerByNameTagRegion get the player data from the database based on the provided conditions.
 func (p *PlayerService) GetPlayerByNameTagRegion(
+	ctx context.Context,
 	gameName string,
 	gameTag string,
 	region string,
 ) (*models.PlayerInfo, error) {
-	player, err := p.PlayerRepository.GetPlayerByNameTagRegion(gameName, gameTag, region)
+	player, err := p.PlayerRepository.GetPlayerByNameTagRegion(ctx, gameName, gameTag, region)
 	if err != nil {
 		return nil, fmt.Errorf("player not found: %v", err)
 	}
@@ -51,11 +83,13 @@ func (p *PlayerService) GetPlayerByNameTagRegion(
 	return player, nil
 }
 
-// ProcessPlayersFromMatch process each player from a given match.
-// Upserts the players, only updating the data if the match data is newer.
-func (p *PlayerService) ProcessPlayersFromMatch(
+// UpsertPlayersFromMatch upserts each player from a given match, only updating the data if the match data is newer.
+// Must run outside of the match transaction, so the upsert is committed before the lock is released.
+func (p *PlayerService) UpsertPlayersFromMatch(
+	ctx context.Context,
 	participants []matchfetcher.MatchPlayer,
-	matchInfo *models.MatchInfo,
+	matchId string,
+	matchStart time.Time,
 	region regions.SubRegion,
 ) ([]*models.PlayerInfo, map[string]matchfetcher.MatchPlayer, error) {
 	// Variables for batching or search.
@@ -71,7 +105,7 @@ func (p *PlayerService) ProcessPlayersFromMatch(
 			RiotIdTagline:  participant.RiotIdTagline,
 			SummonerLevel:  participant.SummonerLevel,
 			Region:         region,
-			UpdatedAt:      matchInfo.MatchStart,
+			UpdatedAt:      matchStart,
 		}
 
 		participantByPuuid[player.Puuid] = participant
@@ -82,28 +116,33 @@ func (p *PlayerService) ProcessPlayersFromMatch(
 	// Get the mutex for the player service to avoid deadlocks when using goroutines.
 	// Need to be in the service to not slow other regions.
 	p.upsertMu.Lock()
-	if err := p.PlayerRepository.UpsertPlayerBatch(playersToUpsert); err != nil {
-		log.Printf("Couldn't create/update the players for the match %s: %v", matchInfo.MatchId, err)
+	if err := p.PlayerRepository.UpsertPlayerBatch(ctx, playersToUpsert); err != nil {
+		slog.ErrorContext(ctx, "Couldn't create/update the players", "match_id", matchId, "error", err)
 		p.upsertMu.Unlock()
 		return nil, nil, err
 	}
 	p.upsertMu.Unlock()
 
-	var playerIds []uint
-
-	for _, player := range playersToUpsert {
-		playerIds = append(playerIds, player.ID)
-	}
+	return playersToUpsert, participantByPuuid, nil
+}
 
+// SetMatchAverageRating sets the average rating of a ranked match from the ratings of its players.
+func (p *PlayerService) SetMatchAverageRating(ctx context.Context, players []*models.PlayerInfo, matchInfo *models.MatchInfo) {
 	// Extract the queue value to get from the rating entries.
 	// Must be ranked solo/duo or flex.
 	queue, exists := queuevalues.RankedQueueValue[matchInfo.QueueId]
-	if exists {
-		avgRating := p.RatingRepository.GetAverageRatingOnMatchByPlayerId(playerIds, matchInfo.ID, matchInfo.MatchStart, queue)
-		if err := p.MatchRepository.SetAverageRating(matchInfo.ID, avgRating); err != nil {
-			log.Printf("Couldn't set average rating for the match %s: %v", matchInfo.MatchId, err)
-		}
+	if !exists {
+		return
 	}
 
-	return playersToUpsert, participantByPuuid, nil
+	var playerIds []uint
+
+	for _, player := range players {
+		playerIds = append(playerIds, player.ID)
+	}
+
+	avgRating := p.RatingRepository.GetAverageRatingOnMatchByPlayerId(ctx, playerIds, matchInfo.ID, matchInfo.MatchStart, queue)
+	if err := p.MatchRepository.SetAverageRating(ctx, matchInfo.ID, avgRating); err != nil {
+		slog.ErrorContext(ctx, "Couldn't set the average rating", "match_id", matchInfo.MatchId, "error", err)
+	}
 }
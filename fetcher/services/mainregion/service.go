@@ -7,42 +7,57 @@ import (
 	"goleague/fetcher/data"
 	"goleague/fetcher/repositories"
 	"goleague/pkg/config"
+	"goleague/pkg/database"
 	"goleague/pkg/database/models"
 	"goleague/pkg/logger"
+	"goleague/pkg/metrics"
 	"goleague/pkg/regions"
 	queuevalues "goleague/pkg/riotvalues/queue"
+	"goleague/pkg/tracing"
 	"slices"
+	"strconv"
 	"sync"
 	"time"
 
+	matchfetcher "goleague/fetcher/data/match"
 	playerfetcher "goleague/fetcher/data/player"
 
 	eventservice "goleague/fetcher/services/mainregion/events"
 	matchservice "goleague/fetcher/services/mainregion/match"
 	playerservice "goleague/fetcher/services/mainregion/player"
 
+	"go.opentelemetry.io/otel/attribute"
 	"gorm.io/gorm"
 )
 
 // MainRegionConfig is the configuration of the main region.
 type MainRegionConfig struct {
-	MaxRetries int
+	MaxRetries          int
+	TimelineMinPriority int
 }
 
 // Result of a single match fetch.
+// The reason is only set on failures, permanent ones are never retried.
 type matchResult struct {
 	matchId     string
+	queueId     int
 	totalTime   time.Duration
 	fetchTime   time.Duration
 	processTime time.Duration
 	err         error
+	reason      string
+	permanent   bool
+	deferred    bool
+	resumed     bool
 }
 
 // MainRegionService coordinates data fetching and processing for a specific main region.
 type MainRegionService struct {
 	config             MainRegionConfig
+	db                 *gorm.DB
 	fetcher            data.MainFetcher
 	eventService       *eventservice.EventService
+	FailedMatchRepo    repositories.FailedMatchRepository
 	matchService       *matchservice.MatchService
 	playerService      *playerservice.PlayerService
 	timelineService    *matchservice.TimelineService
@@ -50,14 +65,15 @@ type MainRegionService struct {
 	PlayerRepository   repositories.PlayerRepository
 	RatingRepository   repositories.RatingRepository
 	TimelineRepository repositories.TimelineRepository
-	logger             *logger.NewLogger
+	logger             *logger.Logger
 	MainRegion         regions.MainRegion
 }
 
 // newMainRegionConfig creates the main region default config.
-func newMainRegionConfig() *MainRegionConfig {
+func newMainRegionConfig(config *config.Config) *MainRegionConfig {
 	return &MainRegionConfig{
-		MaxRetries: 3,
+		MaxRetries:          3,
+		TimelineMinPriority: config.Ingestion.TimelineMinPriority,
 	}
 }
 
@@ -89,10 +105,15 @@ func NewMainRegionService(
 		return nil, errors.New("failed to start the timeline service")
 	}
 
-	mainRegionConfig := *newMainRegionConfig()
+	failedMatchRepository, err := repositories.NewFailedMatchRepository(db)
+	if err != nil {
+		return nil, errors.New("failed to start the failed match repository")
+	}
+
+	mainRegionConfig := *newMainRegionConfig(config)
 
 	// Create the logger.
-	logger, err := logger.CreateLogger(config)
+	logger, err := logger.New(config, "service", "fetcher", "region", region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start the logger on sub region %s: %v", region, err)
 	}
@@ -129,8 +150,10 @@ func NewMainRegionService(
 	// Return the new region service.
 	return &MainRegionService{
 		config:             mainRegionConfig,
+		db:                 db,
 		fetcher:            *fetcher,
 		eventService:       eventservice,
+		FailedMatchRepo:    failedMatchRepository,
 		matchService:       matchService,
 		playerService:      playerService,
 		timelineService:    timelineService,
@@ -145,12 +168,13 @@ func NewMainRegionService(
 
 // GetLogger returns the logger instance.
 // Used for manual closing of the logs.
-func (p *MainRegionService) GetLogger() *logger.NewLogger {
+func (p *MainRegionService) GetLogger() *logger.Logger {
 	return p.logger
 }
 
 // getFullMatchList retrieves the full match list of a given player.
 func (p *MainRegionService) getFullMatchList(
+	ctx context.Context,
 	player *models.PlayerInfo,
 ) ([]string, error) {
 	var matchList []string
@@ -164,7 +188,7 @@ func (p *MainRegionService) getFullMatchList(
 
 		for attempt := 1; attempt < int(p.config.MaxRetries); attempt += 1 {
 			// Get the player matches.
-			matches, err = p.fetcher.Player.GetMatchList(player.Puuid, player.LastMatchFetch, offset, false)
+			matches, err = p.fetcher.Player.GetMatchList(ctx, player.Puuid, player.LastMatchFetch, offset, false)
 
 			// Everything went right, just continue normally..
 			if err == nil {
@@ -190,44 +214,73 @@ func (p *MainRegionService) getFullMatchList(
 
 // GetTrueMatchList retrieves the matches that need to be fetched for a given player.
 // Remove all matches that were already fetched.
+// Also returns which of the matches are a new attempt of a previous failure,
+// and the stored matches whose timeline failed and must be resumed.
 func (p *MainRegionService) GetTrueMatchList(
+	ctx context.Context,
 	player *models.PlayerInfo,
-) ([]string, error) {
+) ([]string, map[string]bool, map[string]*models.MatchInfo, error) {
 	var trueMatchList []string
 
-	matchList, err := p.getFullMatchList(player)
+	matchList, err := p.getFullMatchList(ctx, player)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("couldn't get the full match list even after retrying: %v", err)
+	}
+
+	alreadyFetchedList, err := p.MatchRepository.GetAlreadyFetchedMatches(ctx, matchList)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get the full match list even after retrying: %v", err)
+		return nil, nil, nil, fmt.Errorf("couldn't get the already fetched matches: %v", err)
 	}
 
-	alreadyFetchedList, err := p.MatchRepository.GetAlreadyFetchedMatches(matchList)
+	// Matches that failed before are skipped until their next retry.
+	skippedList, err := p.FailedMatchRepo.GetSkippedMatches(ctx, matchList)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't get the already fetched matches: %v", err)
+		return nil, nil, nil, fmt.Errorf("couldn't get the failed matches: %v", err)
 	}
 
-	matchByMatchId := make(map[string]models.MatchInfo)
+	retriedList, err := p.FailedMatchRepo.GetRetriedMatches(ctx, matchList)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("couldn't get the retried matches: %v", err)
+	}
+
+	retriedMatches := make(map[string]bool, len(retriedList))
+	for _, matchId := range retriedList {
+		retriedMatches[matchId] = true
+	}
+
+	ignoredMatches := make(map[string]bool, len(alreadyFetchedList)+len(skippedList))
+	resumedMatches := make(map[string]*models.MatchInfo)
 	for _, fetched := range alreadyFetchedList {
-		matchByMatchId[fetched.MatchId] = fetched
+		// The match was committed but its timeline failed, only the timeline is fetched again.
+		if !fetched.FullyFetched && retriedMatches[fetched.MatchId] {
+			resumedMatches[fetched.MatchId] = &fetched
+			continue
+		}
+		ignoredMatches[fetched.MatchId] = true
+	}
+
+	for _, matchId := range skippedList {
+		ignoredMatches[matchId] = true
 	}
 
 	// Loop through each match.
 	for _, matchId := range matchList {
 		// If it wasn't fetched already, then fetch it.
-		_, exists := matchByMatchId[matchId]
-		if !exists {
+		if !ignoredMatches[matchId] {
 			trueMatchList = append(trueMatchList, matchId)
 		}
 	}
-	return trueMatchList, nil
+	return trueMatchList, retriedMatches, resumedMatches, nil
 }
 
 // GetTrueMatchList retrieves the matches that need to be fetched for a given player.
 // Remove all matches that were already fetched.
 func (p *MainRegionService) GetAccount(
+	ctx context.Context,
 	gameName string,
 	tagLine string,
 ) (*playerfetcher.Account, error) {
-	account, err := p.fetcher.Player.GetPlayerAccount(gameName, tagLine, true)
+	account, err := p.fetcher.Player.GetPlayerAccount(ctx, gameName, tagLine, true)
 	if err != nil {
 		return nil, fmt.Errorf("player not found: %v", err)
 	}
@@ -237,11 +290,12 @@ func (p *MainRegionService) GetAccount(
 
 // GetPlayerByNameTagRegion is a wripper to the player service call..
 func (p *MainRegionService) GetPlayerByNameTagRegion(
+	ctx context.Context,
 	gameName string,
 	gameTag string,
 	region string,
 ) (*models.PlayerInfo, error) {
-	return p.playerService.GetPlayerByNameTagRegion(gameName, gameTag, region)
+	return p.playerService.GetPlayerByNameTagRegion(ctx, gameName, gameTag, region)
 }
 
 // ProcessPlayerHistory process the player match history with Goroutines.
@@ -249,7 +303,7 @@ func (p *MainRegionService) ProcessPlayerHistory(
 	ctx context.Context,
 	player *models.PlayerInfo,
 	subRegion regions.SubRegion,
-	logger *logger.NewLogger,
+	logger *logger.Logger,
 	maxConcurrency int,
 	onDemand bool,
 ) (
@@ -258,14 +312,17 @@ func (p *MainRegionService) ProcessPlayerHistory(
 	error,
 ) {
 	fetchedMatches := 0
+	logger = logger.With("sub_region", subRegion, "player_id", player.ID)
 	select {
 	default:
-		trueMatchList, err := p.GetTrueMatchList(player)
+		trueMatchList, retriedMatches, resumedMatches, err := p.GetTrueMatchList(ctx, player)
 		if err != nil {
-			logger.Errorf("Couldn't get the true match list: %v", err)
+			logger.Error("Couldn't get the true match list", "error", err)
 			return player, 0, err
 		}
 
+		deferTimeline := p.shouldDeferTimeline(ctx, player, onDemand, logger)
+
 		matchChan := make(chan string, len(trueMatchList))
 		resultChan := make(chan matchResult, len(trueMatchList))
 
@@ -280,7 +337,7 @@ func (p *MainRegionService) ProcessPlayerHistory(
 
 		for range maxConcurrency {
 			wg.Add(1)
-			go p.matchWorker(matchChan, resultChan, subRegion, &wg, onDemand)
+			go p.matchWorker(ctx, matchChan, resultChan, subRegion, &wg, onDemand, deferTimeline, resumedMatches)
 		}
 
 		// Close result channel when all workers are done
@@ -294,24 +351,35 @@ func (p *MainRegionService) ProcessPlayerHistory(
 
 		for result := range resultChan {
 			if result.err != nil {
-				logger.Errorf("Error processing match %s: %v", result.matchId, result.err)
+				logger.Error("Error processing match", "match_id", result.matchId, "reason", result.reason, "error", result.err)
+				p.recordMatchFailure(ctx, result, subRegion, logger)
 				if firstError == nil {
 					firstError = result.err
 				}
 				continue
 			}
 			fetchedMatches++
-			logger.Infof("Created: Match %-15s on %1.2f seconds: FetchTime (%1.2f) - ProcessingTime(%1.2f)",
-				result.matchId,
-				result.totalTime.Seconds(),
-				result.fetchTime.Seconds(),
-				result.processTime.Seconds(),
+			p.recordMatchMetrics(result, subRegion)
+
+			// Clean the previous failure of a retried match.
+			if retriedMatches[result.matchId] {
+				if err := p.FailedMatchRepo.DeleteFailure(ctx, result.matchId); err != nil {
+					logger.Error("Couldn't clean the match failure", "match_id", result.matchId, "error", err)
+				}
+			}
+			logger.Info("Created match",
+				"match_id", result.matchId,
+				"timeline_deferred", result.deferred,
+				"timeline_resumed", result.resumed,
+				"total_seconds", result.totalTime.Seconds(),
+				"fetch_seconds", result.fetchTime.Seconds(),
+				"processing_seconds", result.processTime.Seconds(),
 			)
 		}
 
 		// Set the last fetch regardless of any match processing errors
-		if err := p.PlayerRepository.SetFetched(player.ID); err != nil {
-			logger.Errorf("Couldn't set the last fetch date for the player with ID %d: %v", player.ID, err)
+		if err := p.PlayerRepository.SetFetched(ctx, player.ID); err != nil {
+			logger.Error("Couldn't set the last fetch date for the player", "error", err)
 		}
 
 		return player, fetchedMatches, firstError
@@ -320,33 +388,88 @@ func (p *MainRegionService) ProcessPlayerHistory(
 	}
 }
 
+// shouldDeferTimeline checks if the timelines of the player matches can wait until requested.
+// On demand fetches always get the full match, since someone is waiting for it.
+func (p *MainRegionService) shouldDeferTimeline(ctx context.Context, player *models.PlayerInfo, onDemand bool, logger *logger.Logger) bool {
+	if onDemand || p.config.TimelineMinPriority <= 0 {
+		return false
+	}
+
+	priority, err := p.PlayerRepository.GetFetchPriority(ctx, player.ID)
+	if err != nil {
+		logger.Error("Couldn't get the player fetch priority", "error", err)
+		return false
+	}
+
+	return priority < p.config.TimelineMinPriority
+}
+
+// recordMatchMetrics records the ingestion of a match, splitting the fetch and processing time.
+func (p *MainRegionService) recordMatchMetrics(result matchResult, subRegion regions.SubRegion) {
+	region := string(subRegion)
+	metrics.MatchesIngestedTotal.WithLabelValues(region, strconv.Itoa(result.queueId)).Inc()
+	metrics.MatchFetchDuration.WithLabelValues(region).Observe(result.fetchTime.Seconds())
+	metrics.MatchProcessDuration.WithLabelValues(region).Observe(result.processTime.Seconds())
+}
+
+// recordMatchFailure saves the failure, so the match isn't downloaded again on every pass.
+func (p *MainRegionService) recordMatchFailure(ctx context.Context, result matchResult, subRegion regions.SubRegion, logger *logger.Logger) {
+	failure := &models.FailedMatch{
+		MatchId:   result.matchId,
+		Region:    subRegion,
+		Reason:    result.reason,
+		LastError: result.err.Error(),
+		Permanent: result.permanent,
+	}
+
+	if err := p.FailedMatchRepo.RecordFailure(ctx, failure); err != nil {
+		logger.Error("Couldn't record the match failure", "match_id", result.matchId, "error", err)
+	}
+}
+
 // matchWorker processes matches from the channel.
+// The resumed matches are already stored, only their timeline is fetched.
 func (p *MainRegionService) matchWorker(
+	ctx context.Context,
 	matchChan <-chan string,
 	resultChan chan<- matchResult,
 	subRegion regions.SubRegion,
 	wg *sync.WaitGroup,
 	onDemand bool,
+	deferTimeline bool,
+	resumedMatches map[string]*models.MatchInfo,
 ) {
 	defer wg.Done()
 
 	for matchId := range matchChan {
-		result := p.processMatch(matchId, subRegion, onDemand)
+		if matchInfo, ok := resumedMatches[matchId]; ok {
+			resultChan <- p.resumeMatchTimeline(ctx, matchInfo, onDemand)
+			continue
+		}
+
+		result := p.processMatch(ctx, matchId, subRegion, onDemand, deferTimeline)
 		resultChan <- result
 	}
 }
 
 func (p *MainRegionService) processMatch(
+	ctx context.Context,
 	matchId string,
 	subRegion regions.SubRegion,
 	onDemand bool,
+	deferTimeline bool,
 ) matchResult {
+	ctx, span := tracing.StartSpan(ctx, "match.process")
+	span.SetAttributes(attribute.String("match.id", matchId))
+	defer span.End()
+
 	matchfetchStart := time.Now()
-	matchData, err := p.matchService.GetMatchData(matchId, onDemand)
+	matchData, err := p.matchService.GetMatchData(ctx, matchId, onDemand)
 	if err != nil {
 		return matchResult{
 			matchId: matchId,
 			err:     fmt.Errorf("couldn't get the match data for the match %s: %v", matchId, err),
+			reason:  models.FailureMatchFetch,
 		}
 	}
 
@@ -354,18 +477,67 @@ func (p *MainRegionService) processMatch(
 	// They can have bots, which mess with the PUUIDs logic.
 	if !slices.Contains(queuevalues.TreatedQueues, matchData.Info.QueueId) {
 		return matchResult{
-			matchId: matchId,
-			err:     fmt.Errorf("match %s is of untreated gamemode: %d", matchId, matchData.Info.QueueId),
+			matchId:   matchId,
+			err:       fmt.Errorf("match %s is of untreated gamemode: %d", matchId, matchData.Info.QueueId),
+			reason:    models.FailureUntreatedQueue,
+			permanent: true,
 		}
 	}
 
 	matchParseStart := time.Now()
 
-	matchInfo, _, matchStats, err := p.matchService.ProcessMatchData(matchData, matchId, subRegion)
+	// The players are upserted and committed before the match transaction, while holding the upsert lock.
+	// Inside the transaction their rows would stay locked until the commit, deadlocking the concurrent matches.
+	players, participantByPuuid, err := p.matchService.UpsertMatchPlayers(ctx, matchData, matchId, subRegion)
+	if err != nil {
+		return matchResult{
+			matchId: matchId,
+			err:     fmt.Errorf("couldn't process the players for the match %s: %v", matchId, err),
+			reason:  models.FailureMatchProcess,
+		}
+	}
+
+	// The match and stats are committed together, or not at all.
+	var matchInfo *models.MatchInfo
+	var matchStats []*models.MatchStats
+	err = p.db.Transaction(func(tx *gorm.DB) error {
+		txMatchService, err := p.matchService.WithTx(tx)
+		if err != nil {
+			return err
+		}
+
+		matchInfo, _, matchStats, err = txMatchService.ProcessMatchData(ctx, matchData, matchId, players, participantByPuuid)
+		if err != nil || !deferTimeline {
+			return err
+		}
+
+		// The timeline is only fetched when someone views the match.
+		txTimelineRepository, err := repositories.NewTimelineRepository(tx)
+		if err != nil {
+			return err
+		}
+
+		return txTimelineRepository.CreatePendingTimeline(ctx, &models.PendingTimeline{
+			MatchId: matchInfo.ID,
+			Region:  subRegion,
+		})
+	})
 	if err != nil {
 		return matchResult{
 			matchId: matchId,
 			err:     fmt.Errorf("couldn't process the data for the match %s: %v", matchId, err),
+			reason:  models.FailureMatchProcess,
+		}
+	}
+
+	if deferTimeline {
+		return matchResult{
+			matchId:     matchId,
+			queueId:     matchInfo.QueueId,
+			totalTime:   time.Since(matchfetchStart),
+			fetchTime:   matchParseStart.Sub(matchfetchStart),
+			processTime: time.Since(matchParseStart),
+			deferred:    true,
 		}
 	}
 
@@ -376,31 +548,186 @@ func (p *MainRegionService) processMatch(
 	}
 
 	timelineFetchStart := time.Now()
-	matchTimeline, err := p.timelineService.GetMatchTimeline(matchId, onDemand)
+	matchTimeline, err := p.timelineService.GetMatchTimeline(ctx, matchId, onDemand)
 	if err != nil {
 		return matchResult{
 			matchId: matchId,
 			err:     fmt.Errorf("couldn't get the match timeline for the match %s: %v", matchId, err),
+			reason:  models.FailureTimelineFetch,
 		}
 
 	}
 
 	timelineParseStart := time.Now()
-	err = p.timelineService.ProcessMatchTimeline(matchTimeline, statByPuuid, matchInfo, p.MatchRepository)
+	err = database.CopyTransaction(ctx, p.db, func(tx *gorm.DB) error {
+		return p.ingestTimeline(tx, matchTimeline, statByPuuid, matchInfo)
+	})
 	if err != nil {
 		return matchResult{
 			matchId: matchId,
 			err:     fmt.Errorf("couldn't process the timeline data for the match %s: %v", matchId, err),
+			reason:  models.FailureTimelineProcess,
 		}
 	}
 
-	p.MatchRepository.SetFullyFetched(matchInfo.ID)
-
 	return matchResult{
 		matchId:     matchId,
+		queueId:     matchInfo.QueueId,
 		err:         nil,
 		totalTime:   time.Since(matchfetchStart),
 		fetchTime:   matchParseStart.Sub(matchfetchStart) + timelineParseStart.Sub(timelineFetchStart),
 		processTime: timelineFetchStart.Sub(matchParseStart) + time.Since(timelineParseStart),
 	}
 }
+
+// FetchDeferredTimeline fetches the timeline of a match that had it deferred.
+// Returns if the match is fully fetched after the call.
+func (p *MainRegionService) FetchDeferredTimeline(ctx context.Context, matchId string) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "match.deferred_timeline")
+	span.SetAttributes(attribute.String("match.id", matchId))
+	defer span.End()
+
+	matchInfo, err := p.MatchRepository.GetMatchByMatchId(ctx, matchId)
+	if err != nil {
+		return false, fmt.Errorf("couldn't get the match %s: %v", matchId, err)
+	}
+
+	if matchInfo.FullyFetched {
+		return true, nil
+	}
+
+	statByPuuid, err := p.MatchRepository.GetStatIdsByPuuid(ctx, matchInfo.ID)
+	if err != nil {
+		return false, fmt.Errorf("couldn't get the stats of the match %s: %v", matchId, err)
+	}
+
+	matchTimeline, err := p.timelineService.GetMatchTimeline(ctx, matchId, true)
+	if err != nil {
+		return false, fmt.Errorf("couldn't get the match timeline for the match %s: %v", matchId, err)
+	}
+
+	if err := p.completeTimeline(ctx, matchTimeline, statByPuuid, matchInfo); err != nil {
+		return false, fmt.Errorf("couldn't process the timeline data for the match %s: %v", matchId, err)
+	}
+
+	return true, nil
+}
+
+// resumeMatchTimeline fetches the timeline of a stored match whose timeline failed before.
+// The failures are returned with the timeline reasons, so the backoff of the failed match keeps applying.
+func (p *MainRegionService) resumeMatchTimeline(
+	ctx context.Context,
+	matchInfo *models.MatchInfo,
+	onDemand bool,
+) matchResult {
+	ctx, span := tracing.StartSpan(ctx, "match.resume_timeline")
+	span.SetAttributes(attribute.String("match.id", matchInfo.MatchId))
+	defer span.End()
+
+	matchId := matchInfo.MatchId
+	statByPuuid, err := p.MatchRepository.GetStatIdsByPuuid(ctx, matchInfo.ID)
+	if err != nil {
+		return matchResult{
+			matchId: matchId,
+			err:     fmt.Errorf("couldn't get the stats of the match %s: %v", matchId, err),
+			reason:  models.FailureTimelineProcess,
+		}
+	}
+
+	timelineFetchStart := time.Now()
+	matchTimeline, err := p.timelineService.GetMatchTimeline(ctx, matchId, onDemand)
+	if err != nil {
+		return matchResult{
+			matchId: matchId,
+			err:     fmt.Errorf("couldn't get the match timeline for the match %s: %v", matchId, err),
+			reason:  models.FailureTimelineFetch,
+		}
+	}
+
+	timelineParseStart := time.Now()
+	if err := p.completeTimeline(ctx, matchTimeline, statByPuuid, matchInfo); err != nil {
+		return matchResult{
+			matchId: matchId,
+			err:     fmt.Errorf("couldn't process the timeline data for the match %s: %v", matchId, err),
+			reason:  models.FailureTimelineProcess,
+		}
+	}
+
+	return matchResult{
+		matchId:     matchId,
+		queueId:     matchInfo.QueueId,
+		totalTime:   time.Since(timelineFetchStart),
+		fetchTime:   timelineParseStart.Sub(timelineFetchStart),
+		processTime: time.Since(timelineParseStart),
+		resumed:     true,
+	}
+}
+
+// completeTimeline saves the timeline of a stored match that isn't fully fetched yet.
+// Does nothing if another request completed the match first.
+func (p *MainRegionService) completeTimeline(
+	ctx context.Context,
+	matchTimeline *matchfetcher.MatchTimeline,
+	statByPuuid map[string]uint64,
+	matchInfo *models.MatchInfo,
+) error {
+	return database.CopyTransaction(ctx, p.db, func(tx *gorm.DB) error {
+		txTimelineRepository, err := repositories.NewTimelineRepository(tx)
+		if err != nil {
+			return err
+		}
+
+		txMatchRepository, err := repositories.NewMatchRepository(tx)
+		if err != nil {
+			return err
+		}
+
+		// Locking the match holds concurrent ingestions of the same timeline until the commit.
+		current, err := txMatchRepository.GetMatchForUpdate(ctx, matchInfo.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := txTimelineRepository.DeletePendingTimeline(ctx, matchInfo.ID); err != nil {
+			return err
+		}
+
+		if current.FullyFetched {
+			return nil
+		}
+
+		return p.ingestTimeline(tx, matchTimeline, statByPuuid, matchInfo)
+	})
+}
+
+// ingestTimeline replaces the timeline of a match and marks it as fully fetched.
+// Any previous partial timeline is removed first, so the ingestion can be retried.
+// Must run inside a copy transaction, so the frames and events are inserted with COPY.
+func (p *MainRegionService) ingestTimeline(
+	tx *gorm.DB,
+	matchTimeline *matchfetcher.MatchTimeline,
+	statByPuuid map[string]uint64,
+	matchInfo *models.MatchInfo,
+) error {
+	ctx := tx.Statement.Context
+
+	txTimelineService, err := p.timelineService.WithTx(tx)
+	if err != nil {
+		return err
+	}
+
+	txMatchRepository, err := repositories.NewMatchRepository(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := txTimelineService.TimelineRepository.DeleteMatchTimeline(ctx, matchInfo.ID, matchInfo.MatchStart); err != nil {
+		return fmt.Errorf("couldn't clean the previous timeline: %v", err)
+	}
+
+	if err := txTimelineService.ProcessMatchTimeline(ctx, matchTimeline, statByPuuid, matchInfo, txMatchRepository); err != nil {
+		return err
+	}
+
+	return txMatchRepository.SetFullyFetched(ctx, matchInfo.ID)
+}
@@ -1,6 +1,7 @@
 package batchservice
 
 import (
+	"context"
 	"fmt"
 	leaguefetcher "goleague/fetcher/data/league"
 	leagueservice "goleague/fetcher/services/subregion/league"
@@ -15,7 +16,7 @@ type BatchService struct {
 	leagueService *leagueservice.LeagueService
 	playerService *playerservice.PlayerService
 	ratingService *ratingservice.RatingService
-	logger        *logger.NewLogger
+	logger        *logger.Logger
 	subRegion     regions.SubRegion
 }
 
@@ -24,7 +25,7 @@ func NewBatchService(
 	leagueService *leagueservice.LeagueService,
 	playerService *playerservice.PlayerService,
 	ratingService *ratingservice.RatingService,
-	logger *logger.NewLogger,
+	logger *logger.Logger,
 	subRegion regions.SubRegion,
 ) *BatchService {
 	return &BatchService{
@@ -37,7 +38,7 @@ func NewBatchService(
 }
 
 // ProcessBatchEntry processes a batch of league entries.
-func (s *BatchService) ProcessBatchEntry(entries []leaguefetcher.LeagueEntry, queue string) error {
+func (s *BatchService) ProcessBatchEntry(ctx context.Context, entries []leaguefetcher.LeagueEntry, queue string) error {
 	// If empty just return.
 	if len(entries) == 0 {
 		return nil
@@ -47,31 +48,31 @@ func (s *BatchService) ProcessBatchEntry(entries []leaguefetcher.LeagueEntry, qu
 	puuids, entryByPuuid := s.leagueService.ExtractPuuidsFromEntries(entries)
 
 	// Get existing players.
-	existingPlayers, err := s.playerService.GetPlayersByPuuids(puuids)
+	existingPlayers, err := s.playerService.GetPlayersByPuuids(ctx, puuids)
 	if err != nil {
 		return fmt.Errorf("couldn't get the existing players by puuid: %v", err)
 	}
 
 	// Process players (create missing ones).
-	playersToCreate, err := s.playerService.ProcessPlayersFromEntries(entries, existingPlayers)
+	playersToCreate, err := s.playerService.ProcessPlayersFromEntries(ctx, entries, existingPlayers)
 	if err != nil {
 		return fmt.Errorf("couldn't create the players from the entries: %v", err)
 	}
 
 	// Log player creation if any.
 	if len(playersToCreate) > 0 {
-		s.logger.Infof("Created: %d Players - Region: %v", len(playersToCreate), s.subRegion)
+		s.logger.Info("Created players", "count", len(playersToCreate))
 	}
 
 	playerIDs := s.playerService.GetPlayerIDsFromMap(existingPlayers)
 
 	// Get last ratings for these players.
-	lastRatings, err := s.ratingService.GetLastRatingsByPlayerIdsAndQueue(playerIDs, queue)
+	lastRatings, err := s.ratingService.GetLastRatingsByPlayerIdsAndQueue(ctx, playerIDs, queue)
 	if err != nil {
 		return fmt.Errorf("error fetching last ratings: %v", err)
 	}
 
-	createdRatings, err := s.ratingService.ProcessRatings(existingPlayers, entryByPuuid, lastRatings, queue)
+	createdRatings, err := s.ratingService.ProcessRatings(ctx, existingPlayers, entryByPuuid, lastRatings, queue)
 	if err != nil {
 		return fmt.Errorf("error processing the ratings: %v", err)
 	}
@@ -82,8 +83,12 @@ func (s *BatchService) ProcessBatchEntry(entries []leaguefetcher.LeagueEntry, qu
 		tier := createdRatings[0].Tier
 		rank := createdRatings[0].Rank
 
-		s.logger.Infof("Created: %d - Queue: %s - Region: %v - Tier: %v - Rank: %v",
-			len(createdRatings), queue, s.subRegion, tier, rank)
+		s.logger.Info("Created ratings",
+			"count", len(createdRatings),
+			"queue", queue,
+			"tier", tier,
+			"rank", rank,
+		)
 	}
 
 	return nil
@@ -1,6 +1,7 @@
 package leagueservice
 
 import (
+	"context"
 	"fmt"
 	"goleague/fetcher/data"
 	leaguefetcher "goleague/fetcher/data/league"
@@ -46,13 +47,13 @@ func (s *LeagueService) ExtractPuuidsFromEntries(entries []leaguefetcher.LeagueE
 }
 
 // GetLeagueEntries fetches the league entries.
-func (s *LeagueService) GetLeagueEntries(tier string, rank string, queue string, page int) ([]leaguefetcher.LeagueEntry, error) {
+func (s *LeagueService) GetLeagueEntries(ctx context.Context, tier string, rank string, queue string, page int) ([]leaguefetcher.LeagueEntry, error) {
 	var entries []leaguefetcher.LeagueEntry
 	var err error
 
 	// Try to get the entries with retry.
 	for attempt := 1; attempt <= s.maxRetries; attempt++ {
-		entries, err = s.fetcher.League.GetLeagueEntries(tier, rank, queue, page)
+		entries, err = s.fetcher.League.GetLeagueEntries(ctx, tier, rank, queue, page)
 		if err == nil {
 			break
 		}
@@ -72,13 +73,13 @@ func (s *LeagueService) GetLeagueEntries(tier string, rank string, queue string,
 }
 
 // GetPlayerEntries fetches all league entries for a given player based on the PUUID.
-func (s *LeagueService) GetPlayerEntries(puuid string, onDemand bool) ([]leaguefetcher.LeagueEntry, error) {
+func (s *LeagueService) GetPlayerEntries(ctx context.Context, puuid string, onDemand bool) ([]leaguefetcher.LeagueEntry, error) {
 	var entries []leaguefetcher.LeagueEntry
 	var err error
 
 	// Try to get the entries with retry.
 	for attempt := 1; attempt <= s.maxRetries; attempt++ {
-		entries, err = s.fetcher.League.GetLeagueEntriesByPuuid(puuid, onDemand)
+		entries, err = s.fetcher.League.GetLeagueEntriesByPuuid(ctx, puuid, onDemand)
 		if err == nil {
 			break
 		}
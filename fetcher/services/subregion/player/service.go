@@ -1,6 +1,7 @@
 package playerservice
 
 import (
+	"context"
 	"fmt"
 	"goleague/fetcher/data"
 	leaguefetcher "goleague/fetcher/data/league"
@@ -37,17 +38,18 @@ func (s *PlayerService) GetPlayerIDsFromMap(players map[string]*models.PlayerInf
 }
 
 // GetPlayersByPuuids fetches existing players by their PUUIDs.
-func (s *PlayerService) GetPlayersByPuuids(puuids []string) (map[string]*models.PlayerInfo, error) {
-	return s.repository.GetPlayersByPuuids(puuids)
+func (s *PlayerService) GetPlayersByPuuids(ctx context.Context, puuids []string) (map[string]*models.PlayerInfo, error) {
+	return s.repository.GetPlayersByPuuids(ctx, puuids)
 }
 
 // Wrapper for getting the summoner data from the fetcher.
-func (s *PlayerService) GetSummonerData(puuid string, onDemand bool) (*playerfetcher.SummonerByPuuid, error) {
-	return s.fetcher.Player.GetSummonerDataByPuuid(puuid, onDemand)
+func (s *PlayerService) GetSummonerData(ctx context.Context, puuid string, onDemand bool) (*playerfetcher.SummonerByPuuid, error) {
+	return s.fetcher.Player.GetSummonerDataByPuuid(ctx, puuid, onDemand)
 }
 
 // ProcessPlayersFromEntries processes players from league entries, creating any that don't exist.
 func (s *PlayerService) ProcessPlayersFromEntries(
+	ctx context.Context,
 	entries []leaguefetcher.LeagueEntry,
 	existingPlayers map[string]*models.PlayerInfo,
 ) ([]*models.PlayerInfo, error) {
@@ -67,7 +69,7 @@ func (s *PlayerService) ProcessPlayersFromEntries(
 
 	// Creates the list of players.
 	if len(playersToCreate) > 0 {
-		if err := s.repository.CreatePlayersBatch(playersToCreate); err != nil {
+		if err := s.repository.CreatePlayersBatch(ctx, playersToCreate); err != nil {
 			return nil, fmt.Errorf("error inserting %v new players: %v", len(playersToCreate), err)
 		}
 
@@ -81,8 +83,8 @@ func (s *PlayerService) ProcessPlayersFromEntries(
 }
 
 // ProcessSummonerData gets a summoner info from the Riot API and upserts the entry in the database.
-func (s *PlayerService) ProcessSummonerData(playeraccount *playerfetcher.Account, onDemand bool) (*models.PlayerInfo, error) {
-	summonerData, err := s.GetSummonerData(playeraccount.Puuid, onDemand)
+func (s *PlayerService) ProcessSummonerData(ctx context.Context, playeraccount *playerfetcher.Account, onDemand bool) (*models.PlayerInfo, error) {
+	summonerData, err := s.GetSummonerData(ctx, playeraccount.Puuid, onDemand)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get summoner data: %w", err)
 	}
@@ -101,7 +103,7 @@ func (s *PlayerService) ProcessSummonerData(playeraccount *playerfetcher.Account
 		fullSummoner,
 	}
 
-	err = s.repository.UpsertPlayerBatch(fullSummonerArray)
+	err = s.repository.UpsertPlayerBatch(ctx, fullSummonerArray)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't save player on database: %w", err)
 	}
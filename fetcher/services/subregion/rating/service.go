@@ -1,6 +1,7 @@
 package ratingservice
 
 import (
+	"context"
 	"fmt"
 	leaguefetcher "goleague/fetcher/data/league"
 	"goleague/fetcher/repositories"
@@ -57,12 +58,13 @@ func (s *RatingService) createRatingFromEntry(
 }
 
 // GetLastRatingsByPlayerIdsAndQueue fetches the last ratings for a list of players.
-func (s *RatingService) GetLastRatingsByPlayerIdsAndQueue(playerIDs []uint, queue string) (map[uint]*models.RatingEntry, error) {
-	return s.repository.GetLastRatingEntryByPlayerIdsAndQueue(playerIDs, queue)
+func (s *RatingService) GetLastRatingsByPlayerIdsAndQueue(ctx context.Context, playerIDs []uint, queue string) (map[uint]*models.RatingEntry, error) {
+	return s.repository.GetLastRatingEntryByPlayerIdsAndQueue(ctx, playerIDs, queue)
 }
 
 // ProcessRatings processes ratings for players, creating new ones when needed.
 func (s *RatingService) ProcessRatings(
+	ctx context.Context,
 	existingPlayers map[string]*models.PlayerInfo,
 	entryByPuuid map[string]leaguefetcher.LeagueEntry,
 	lastRatings map[uint]*models.RatingEntry,
@@ -87,7 +89,7 @@ func (s *RatingService) ProcessRatings(
 
 	// Create the ratings.
 	if len(ratingsToCreate) > 0 {
-		if err := s.repository.CreateBatchRating(ratingsToCreate); err != nil {
+		if err := s.repository.CreateBatchRating(ctx, ratingsToCreate); err != nil {
 			return nil, fmt.Errorf("error creating rating entries: %v", err)
 		}
 	}
@@ -1,6 +1,7 @@
 package subregion
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"goleague/fetcher/data"
@@ -15,6 +16,7 @@ import (
 	"goleague/pkg/database/models"
 	"goleague/pkg/logger"
 	"goleague/pkg/regions"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -25,7 +27,8 @@ type SubRegionService struct {
 	playerService *playerservice.PlayerService
 	ratingService *ratingservice.RatingService
 	batchService  *batchservice.BatchService
-	logger        *logger.NewLogger
+	crawlRepo     repositories.CrawlRepository
+	logger        *logger.Logger
 	subRegion     regions.SubRegion
 }
 
@@ -42,8 +45,13 @@ func NewSubRegionService(config *config.Config, db *gorm.DB, fetcher *data.SubFe
 		return nil, errors.New("failed to start the player repository")
 	}
 
+	crawlRepository, err := repositories.NewCrawlRepository(db)
+	if err != nil {
+		return nil, errors.New("failed to start the crawl repository")
+	}
+
 	// Create the logger.
-	logger, err := logger.CreateLogger(config)
+	logger, err := logger.New(config, "service", "fetcher", "region", region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start the logger on sub region %s: %v", region, err)
 	}
@@ -60,6 +68,7 @@ func NewSubRegionService(config *config.Config, db *gorm.DB, fetcher *data.SubFe
 		playerService: playerService,
 		ratingService: ratingService,
 		batchService:  batchService,
+		crawlRepo:     crawlRepository,
 		logger:        logger,
 		subRegion:     region,
 	}, nil
@@ -67,14 +76,14 @@ func NewSubRegionService(config *config.Config, db *gorm.DB, fetcher *data.SubFe
 
 // GetLogger returns the logger instance.
 // Used for manual closing of the logs.
-func (s *SubRegionService) GetLogger() *logger.NewLogger {
+func (s *SubRegionService) GetLogger() *logger.Logger {
 	return s.logger
 }
 
 // ProcessLeagueRank processes a specific page for a given ranking page.
-func (s *SubRegionService) ProcessLeagueRank(tier string, rank string, queue string, page int) (isLastPage bool, err error) {
+func (s *SubRegionService) ProcessLeagueRank(ctx context.Context, tier string, rank string, queue string, page int) (isLastPage bool, err error) {
 	// Get entries for the current page.
-	entries, err := s.leagueService.GetLeagueEntries(tier, rank, queue, page)
+	entries, err := s.leagueService.GetLeagueEntries(ctx, tier, rank, queue, page)
 	if err != nil {
 		return false, err
 	}
@@ -85,7 +94,7 @@ func (s *SubRegionService) ProcessLeagueRank(tier string, rank string, queue str
 	}
 
 	// Process the batch.
-	if err := s.batchService.ProcessBatchEntry(entries, queue); err != nil {
+	if err := s.batchService.ProcessBatchEntry(ctx, entries, queue); err != nil {
 		return false, fmt.Errorf("error at processing page %d: %v", page, err)
 	}
 
@@ -93,8 +102,8 @@ func (s *SubRegionService) ProcessLeagueRank(tier string, rank string, queue str
 }
 
 // ProcessPlayerLeagueEntries get all league entries for a given player and process them.
-func (s *SubRegionService) ProcessPlayerLeagueEntries(puuid string, onDemand bool) error {
-	entries, err := s.leagueService.GetPlayerEntries(puuid, onDemand)
+func (s *SubRegionService) ProcessPlayerLeagueEntries(ctx context.Context, puuid string, onDemand bool) error {
+	entries, err := s.leagueService.GetPlayerEntries(ctx, puuid, onDemand)
 	if err != nil {
 		return err
 	}
@@ -105,7 +114,7 @@ func (s *SubRegionService) ProcessPlayerLeagueEntries(puuid string, onDemand boo
 			continue
 		}
 
-		if err := s.batchService.ProcessBatchEntry(entryArr, *entry.QueueType); err != nil {
+		if err := s.batchService.ProcessBatchEntry(ctx, entryArr, *entry.QueueType); err != nil {
 			return fmt.Errorf("error at processing player league entry: %v", err)
 		}
 	}
@@ -114,6 +123,27 @@ func (s *SubRegionService) ProcessPlayerLeagueEntries(puuid string, onDemand boo
 }
 
 // ProcessSummonerData is a wrapper for the player service call.
-func (s *SubRegionService) ProcessSummonerData(playerAccount *playerfetcher.Account, onDemand bool) (*models.PlayerInfo, error) {
-	return s.playerService.ProcessSummonerData(playerAccount, onDemand)
+func (s *SubRegionService) ProcessSummonerData(ctx context.Context, playerAccount *playerfetcher.Account, onDemand bool) (*models.PlayerInfo, error) {
+	return s.playerService.ProcessSummonerData(ctx, playerAccount, onDemand)
+}
+
+// GetCrawlCursors returns the saved league cursors for the sub region.
+func (s *SubRegionService) GetCrawlCursors(ctx context.Context) ([]models.LeagueCrawlCursor, error) {
+	return s.crawlRepo.GetCursorsBySubRegion(ctx, s.subRegion)
+}
+
+// SaveCrawlCursor persists the next page to be fetched for a given league.
+func (s *SubRegionService) SaveCrawlCursor(ctx context.Context, queue string, tier string, rank string, page int) error {
+	return s.crawlRepo.UpsertCursor(ctx, &models.LeagueCrawlCursor{
+		Region:      s.subRegion,
+		Queue:       queue,
+		Tier:        tier,
+		Rank:        rank,
+		CurrentPage: page,
+	})
+}
+
+// SetCrawlCycleCompleted saves the moment the sub region finished a full league cycle.
+func (s *SubRegionService) SetCrawlCycleCompleted(ctx context.Context) error {
+	return s.crawlRepo.SetCycleCompleted(ctx, s.subRegion, time.Now())
 }
@@ -0,0 +1,105 @@
+package testutil
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// Ten stats for each match, the default seeds three million stats.
+	defaultBenchmarkMatches = 300000
+	benchmarkPlayers        = 50000
+	// Months of matches, each one on its own partition.
+	benchmarkMonths = 6
+)
+
+// BenchmarkMatchCount returns the amount of matches to seed, configurable through BENCHMARK_MATCHES.
+func BenchmarkMatchCount() int {
+	if matches, err := strconv.Atoi(os.Getenv("BENCHMARK_MATCHES")); err == nil && matches > 0 {
+		return matches
+	}
+
+	return defaultBenchmarkMatches
+}
+
+// SeedBenchmarkMatches fills the database with matches spread through the last months.
+// Generated on the database itself, since inserting millions of rows from the client would take longer than the benchmark.
+func SeedBenchmarkMatches(tb testing.TB, db *gorm.DB, matches int) {
+	tb.Helper()
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{
+			query: `
+				SELECT create_match_partitions((date_trunc('month', NOW()) - m * INTERVAL '1 month')::DATE)
+				FROM generate_series(0, ?) m
+			`,
+			args: []any{benchmarkMonths},
+		},
+		{
+			query: `
+				INSERT INTO player_infos (id, puuid, riot_id_game_name, riot_id_tagline, region)
+				SELECT g, 'bench-puuid-' || g, 'Bench' || g, 'BR1', 'BR1'
+				FROM generate_series(1, ?) g
+			`,
+			args: []any{benchmarkPlayers},
+		},
+		{
+			query: `
+				INSERT INTO match_infos (id, game_version, patch, match_id, match_start, match_duration, match_winner, average_rating, queue_id, fully_fetched, created_at)
+				SELECT
+					g,
+					'15.' || (g % 24 + 1) || '.1.1',
+					'15.' || (g % 24 + 1),
+					'BENCH_' || g,
+					NOW() - (g % (? * 30)) * INTERVAL '1 day' - (g % 1440) * INTERVAL '1 minute',
+					1200 + g % 900,
+					CASE WHEN g % 2 = 0 THEN 100 ELSE 200 END,
+					g % 3200,
+					CASE WHEN g % 10 = 0 THEN 450 ELSE 420 END,
+					TRUE,
+					NOW()
+				FROM generate_series(1, ?) g
+			`,
+			args: []any{benchmarkMonths, matches},
+		},
+		{
+			query: `
+				INSERT INTO match_stats (
+					match_id, player_id, match_start, participant_id, team_id, champion_id, team_position,
+					win, kills, deaths, assists, total_minions_killed, neutral_minions_killed
+				)
+				SELECT
+					mi.id,
+					(mi.id * 10 + p) % ? + 1,
+					mi.match_start,
+					p + 1,
+					CASE WHEN p < 5 THEN 100 ELSE 200 END,
+					(mi.id * 7 + p * 13) % 170 + 1,
+					(ARRAY['TOP', 'JUNGLE', 'MIDDLE', 'BOTTOM', 'UTILITY'])[p % 5 + 1],
+					(p < 5) = (mi.match_winner = 100),
+					(mi.id + p) % 15,
+					(mi.id + p * 3) % 12,
+					(mi.id + p * 5) % 20,
+					(mi.id + p) % 250,
+					(mi.id + p) % 40
+				FROM match_infos mi
+				CROSS JOIN generate_series(0, 9) p
+				WHERE mi.match_id LIKE 'BENCH\_%'
+			`,
+			args: []any{benchmarkPlayers},
+		},
+		{query: "ANALYZE"},
+	}
+
+	for _, statement := range statements {
+		if err := db.Exec(statement.query, statement.args...).Error; err != nil {
+			tb.Fatalf("Failed to seed the benchmark data: %v", err)
+		}
+	}
+}
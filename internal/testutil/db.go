@@ -18,7 +18,7 @@ import (
 
 // GetConnections is a singleton implementaudo ssion of the database.
 // Return the connection pool.
-func NewTestConnection(t *testing.T) (*gorm.DB, func()) {
+func NewTestConnection(t testing.TB) (*gorm.DB, func()) {
 	t.Helper()
 
 	ctx := context.Background()
@@ -2,10 +2,13 @@ package config
 
 import (
 	"fmt"
+	"goleague/pkg/regions"
 	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -16,10 +19,15 @@ type Config struct {
 	Bucket      BucketConfig
 	Database    DatabaseConfig
 	Grpc        GRPCConfig
+	Ingestion   IngestionConfig
 	Limits      RiotLimiterConfig
-	PrintLogs   bool
+	Logging     LoggingConfig
+	Metrics     MetricsConfig
 	ProjectRoot string
 	Redis       RedisConfig
+	Regions     *regions.RegionConfig
+	Retention   RetentionConfig
+	Tracing     TracingConfig
 }
 
 type BucketConfig struct {
@@ -45,12 +53,49 @@ type GRPCConfig struct {
 	Port string
 }
 
+type IngestionConfig struct {
+	// Players with a fetch priority below it have the timelines deferred until requested.
+	// Zero never defers.
+	TimelineMinPriority int
+}
+
+type LoggingConfig struct {
+	File   LogFileConfig
+	Format string
+	Level  string
+	Sinks  []string
+}
+
+type LogFileConfig struct {
+	MaxAgeDays int
+	MaxBackups int
+	MaxSizeMB  int
+	Path       string
+}
+
+type MetricsConfig struct {
+	Port string
+}
+
 type RedisConfig struct {
 	Host     string
 	Password string
 	Port     string
 }
 
+type RetentionConfig struct {
+	// Timelines are kept for the matches of the last days or patches.
+	// Zero disables the limit, with both disabled nothing is pruned.
+	TimelineDays    int
+	TimelinePatches int
+}
+
+type TracingConfig struct {
+	Endpoint    string
+	Exporter    string
+	SampleRatio float64
+}
+
 type riotLimits struct {
 	Count         int
 	ResetInterval time.Duration
@@ -68,6 +113,8 @@ const (
 	defaultLowerReset  = 1 // Seconds
 	defaultHigherCount = 100
 	defaultHigherReset = 120 // Seconds
+	defaultMetricsPort = "9090"
+	defaultLogFilePath = "logs/goleague.log"
 )
 
 func Load() (*Config, error) {
@@ -99,7 +146,27 @@ func Load() (*Config, error) {
 	lowerCount := getEnvInt("LIMIT_LOWER_COUNT", defaultLowerCount)
 	lowerReset := getEnvInt("LIMIT_LOWER_RESET", defaultLowerReset)
 
-	printLogs, _ := strconv.ParseBool(os.Getenv("ENABLE_CONSOLE_LOG"))
+	// Log only to the console by default.
+	logSinks := getEnvList("LOG_SINKS")
+	if len(logSinks) == 0 {
+		logSinks = []string{"stdout"}
+	}
+
+	logFilePath := os.Getenv("LOG_FILE_PATH")
+	if logFilePath == "" {
+		logFilePath = defaultLogFilePath
+	}
+
+	// Sample every trace by default.
+	sampleRatio, err := strconv.ParseFloat(os.Getenv("TRACING_SAMPLE_RATIO"), 64)
+	if err != nil {
+		sampleRatio = 1
+	}
+
+	metricsPort := os.Getenv("METRICS_PORT")
+	if metricsPort == "" {
+		metricsPort = defaultMetricsPort
+	}
 
 	jobInterval := (float64(higherReset) / float64(higherCount)) * 1000
 
@@ -127,6 +194,15 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("required database configuration missing")
 	}
 
+	// Get the enabled regions, defaults to every known region.
+	regionConfig, err := regions.NewRegionConfig(
+		getEnvList("ENABLED_REGIONS"),
+		getEnvList("ON_DEMAND_REGIONS"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid region configuration: %w", err)
+	}
+
 	return &Config{
 		ApiKey: apiKey,
 		Bucket: BucketConfig{
@@ -141,6 +217,9 @@ func Load() (*Config, error) {
 			Host: os.Getenv("GRPC_HOST"),
 			Port: os.Getenv("GRPC_PORT"),
 		},
+		Ingestion: IngestionConfig{
+			TimelineMinPriority: getEnvInt("TIMELINE_MIN_PRIORITY", 0),
+		},
 		Limits: RiotLimiterConfig{
 			Lower: riotLimits{
 				Count:         lowerCount,
@@ -152,12 +231,35 @@ func Load() (*Config, error) {
 			},
 			SlowInterval: time.Duration(jobInterval) * time.Millisecond,
 		},
-		PrintLogs: printLogs,
+		Logging: LoggingConfig{
+			File: LogFileConfig{
+				MaxAgeDays: getEnvInt("LOG_FILE_MAX_AGE_DAYS", 7),
+				MaxBackups: getEnvInt("LOG_FILE_MAX_BACKUPS", 5),
+				MaxSizeMB:  getEnvInt("LOG_FILE_MAX_SIZE_MB", 100),
+				Path:       logFilePath,
+			},
+			Format: os.Getenv("LOG_FORMAT"),
+			Level:  os.Getenv("LOG_LEVEL"),
+			Sinks:  logSinks,
+		},
+		Metrics: MetricsConfig{
+			Port: metricsPort,
+		},
 		Redis: RedisConfig{
 			Host:     os.Getenv("REDIS_HOST"),
 			Password: os.Getenv("REDIS_PASSWORD"),
 			Port:     os.Getenv("REDIS_PORT"),
 		},
+		Regions: regionConfig,
+		Retention: RetentionConfig{
+			TimelineDays:    getEnvInt("TIMELINE_RETENTION_DAYS", 0),
+			TimelinePatches: getEnvInt("TIMELINE_RETENTION_PATCHES", 0),
+		},
+		Tracing: TracingConfig{
+			Endpoint:    os.Getenv("TRACING_ENDPOINT"),
+			Exporter:    os.Getenv("TRACING_EXPORTER"),
+			SampleRatio: sampleRatio,
+		},
 	}, nil
 }
 
@@ -173,13 +275,25 @@ func getEnvInt(key string, defaultVal int) int {
 	// Handle the conversion to int.
 	intVal, err := strconv.Atoi(val)
 	if err != nil {
-		log.Printf("Warning: Couldn't parse the value %s=%s as int. Using default %d: %v", key, val, defaultVal, err)
+		slog.Warn("Couldn't parse the value as int, using the default", "key", key, "value", val, "default", defaultVal, "error", err)
 		return defaultVal
 	}
 
 	return intVal
 }
 
+// Convert a comma separated env key to a list, ignoring empty values.
+func getEnvList(key string) []string {
+	var list []string
+	for value := range strings.SplitSeq(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			list = append(list, value)
+		}
+	}
+
+	return list
+}
+
 func findProjectRoot() (string, error) {
 	dir, err := os.Getwd()
 	if err != nil {
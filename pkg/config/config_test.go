@@ -0,0 +1,63 @@
+package config
+
+import (
+	"goleague/pkg/regions"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEnvList(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{name: "empty", value: "", expected: nil},
+		{name: "single", value: "BR1", expected: []string{"BR1"}},
+		{name: "multiple", value: "BR1,KR,EUW1", expected: []string{"BR1", "KR", "EUW1"}},
+		{name: "trimsSpaces", value: " BR1 , KR ", expected: []string{"BR1", "KR"}},
+		{name: "ignoresEmptyValues", value: "BR1,, ,KR,", expected: []string{"BR1", "KR"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_LIST", tt.value)
+			assert.Equal(t, tt.expected, getEnvList("TEST_ENV_LIST"))
+		})
+	}
+}
+
+func TestRegionConfigFromEnv(t *testing.T) {
+	tests := []struct {
+		name          string
+		enabled       string
+		onDemandOnly  string
+		checkRegion   regions.SubRegion
+		isEnabled     bool
+		isBackground  bool
+		expectedError bool
+	}{
+		{name: "defaultsToEverything", checkRegion: "NA1", isEnabled: true, isBackground: true},
+		{name: "onlyConfigured", enabled: "br1, kr", checkRegion: "NA1", isEnabled: false},
+		{name: "onDemand", enabled: "BR1,KR", onDemandOnly: "kr", checkRegion: "KR", isEnabled: true, isBackground: false},
+		{name: "unknownRegion", enabled: "BR1,XX9", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ENABLED_REGIONS", tt.enabled)
+			t.Setenv("ON_DEMAND_REGIONS", tt.onDemandOnly)
+
+			rc, err := regions.NewRegionConfig(getEnvList("ENABLED_REGIONS"), getEnvList("ON_DEMAND_REGIONS"))
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.isEnabled, rc.IsEnabled(tt.checkRegion))
+			assert.Equal(t, tt.isBackground, rc.IsBackground(tt.checkRegion))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Batch size used when the COPY protocol isn't available.
+const copyFallbackBatchSize = 1000
+
+// copyConn is a connection pinned by CopyTransaction.
+// Its transactions keep a reference to the connection, so the COPY runs on it.
+type copyConn struct {
+	*sql.Conn
+}
+
+// BeginTx starts the transaction on the pinned connection.
+func (c copyConn) BeginTx(ctx context.Context, opts *sql.TxOptions) (gorm.ConnPool, error) {
+	tx, err := c.Conn.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &copyTx{Tx: tx, conn: c.Conn}, nil
+}
+
+// copyTx is a transaction started on a pinned connection.
+// Kept as the connection pool of the session, so it survives calls like WithContext.
+type copyTx struct {
+	*sql.Tx
+	conn *sql.Conn
+}
+
+// CopyTransaction runs the function in a transaction pinned to a single connection.
+// The rows inserted through CopyFrom inside it use the COPY protocol, as part of the same transaction.
+func CopyTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("couldn't get the sql database: %w", err)
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("couldn't get a connection from the pool: %w", err)
+	}
+	defer conn.Close()
+
+	// The transaction is started on the pinned connection, so the COPY runs inside it.
+	pinned := db.WithContext(ctx)
+	pinned.Statement.ConnPool = copyConn{Conn: conn}
+
+	return pinned.Transaction(fn)
+}
+
+// CopyFrom inserts the rows with the COPY protocol, on every column of the model.
+// Falls back to batched inserts when the session isn't in a transaction of CopyTransaction.
+func CopyFrom[T any](db *gorm.DB, rows []*T) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	tx, ok := db.Statement.ConnPool.(*copyTx)
+	if !ok {
+		return db.CreateInBatches(&rows, copyFallbackBatchSize).Error
+	}
+	conn := tx.conn
+
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return fmt.Errorf("couldn't parse the model schema: %w", err)
+	}
+
+	// The database generates the auto increment values.
+	var fields []*schema.Field
+	var columns []string
+	for _, name := range stmt.Schema.DBNames {
+		field := stmt.Schema.FieldsByDBName[name]
+		if field.AutoIncrement || !field.Creatable {
+			continue
+		}
+
+		fields = append(fields, field)
+		columns = append(columns, name)
+	}
+
+	ctx := db.Statement.Context
+	source := pgx.CopyFromSlice(len(rows), func(i int) ([]any, error) {
+		row := reflect.ValueOf(rows[i])
+		values := make([]any, len(fields))
+		for j, field := range fields {
+			values[j], _ = field.ValueOf(ctx, row)
+		}
+		return values, nil
+	})
+
+	return conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T", driverConn)
+		}
+
+		_, err := stdlibConn.Conn().CopyFrom(ctx, pgx.Identifier{stmt.Schema.Table}, columns, source)
+		return err
+	})
+}
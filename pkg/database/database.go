@@ -7,6 +7,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 )
 
 // GetConnections is a singleton implementaudo ssion of the database.
@@ -21,6 +22,11 @@ func NewConnection(dsn string) (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to connect to database: %v", err)
 	}
 
+	// Trace the queries, the metrics are already exposed by Prometheus.
+	if err := db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics())); err != nil {
+		return nil, fmt.Errorf("failed to register the tracing plugin: %w", err)
+	}
+
 	// Get the SQL database itself.
 	sqlDb, sqlErr := db.DB()
 
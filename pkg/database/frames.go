@@ -0,0 +1,15 @@
+package database
+
+import "fmt"
+
+// FrameJoin left joins the participant frame of a match stat at the given minute.
+// The query must have the match infos as mi, the frame index depends on its frame interval.
+// Matches without a timeline or shorter than the minute have no frame, so the aggregations ignore them.
+func FrameJoin(alias string, statAlias string, minute int) string {
+	return fmt.Sprintf(`
+		LEFT JOIN participant_frames %[1]s
+		ON %[1]s.match_stat_id = %[2]s.id
+		AND %[1]s.match_start = %[2]s.match_start
+		AND %[1]s.frame_index = %[3]d * 60000 / NULLIF(mi.frame_interval, 0)
+	`, alias, statAlias, minute)
+}
@@ -4,7 +4,7 @@ import (
 	"database/sql"
 	"fmt"
 	"goleague/pkg/config"
-	"log"
+	"log/slog"
 	"path/filepath"
 
 	"github.com/golang-migrate/migrate/v4"
@@ -37,7 +37,7 @@ func RunMigrations(config *config.Config, db *sql.DB) error {
 	}
 
 	if !lockAcquired {
-		log.Println("Another process is already running migrations, skipping...")
+		slog.Info("Another process is already running the migrations, skipping")
 		return nil
 	}
 
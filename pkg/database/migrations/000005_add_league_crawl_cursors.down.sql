@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS league_crawl_cycles;
+
+DROP TABLE IF EXISTS league_crawl_cursors;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE
+    IF NOT EXISTS league_crawl_cursors (
+        region VARCHAR(10) NOT NULL,
+        queue VARCHAR(30) NOT NULL,
+        tier VARCHAR(20) NOT NULL,
+        rank VARCHAR(5) NOT NULL,
+        current_page INTEGER NOT NULL DEFAULT 1,
+        updated_at TIMESTAMP NOT NULL DEFAULT NOW (),
+        PRIMARY KEY (region, queue, tier, rank)
+    );
+
+CREATE TABLE
+    IF NOT EXISTS league_crawl_cycles (
+        region VARCHAR(10) PRIMARY KEY,
+        last_completed_at TIMESTAMP NOT NULL DEFAULT NOW ()
+    );
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_failed_matches_reason;
+
+DROP TABLE IF EXISTS failed_matches;
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE
+    IF NOT EXISTS failed_matches (
+        match_id VARCHAR(20) PRIMARY KEY,
+        region VARCHAR(10) NOT NULL,
+        reason VARCHAR(30) NOT NULL,
+        last_error TEXT NOT NULL DEFAULT '',
+        attempts INTEGER NOT NULL DEFAULT 1,
+        permanent BOOLEAN NOT NULL DEFAULT FALSE,
+        next_retry_at TIMESTAMP NOT NULL DEFAULT NOW (),
+        created_at TIMESTAMP NOT NULL DEFAULT NOW (),
+        updated_at TIMESTAMP NOT NULL DEFAULT NOW ()
+    );
+
+CREATE INDEX IF NOT EXISTS idx_failed_matches_reason ON failed_matches (reason);
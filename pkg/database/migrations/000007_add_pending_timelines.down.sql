@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pending_timelines;
\ No newline at end of file
@@ -0,0 +1,7 @@
+CREATE TABLE
+    IF NOT EXISTS pending_timelines (
+        match_id BIGINT PRIMARY KEY,
+        region VARCHAR(10) NOT NULL,
+        created_at TIMESTAMP NOT NULL DEFAULT NOW (),
+        CONSTRAINT fk_pending_timelines_match FOREIGN KEY (match_id) REFERENCES match_infos (id)
+    );
@@ -0,0 +1,4 @@
+ALTER TABLE match_infos
+ADD COLUMN IF NOT EXISTS timeline_pruned BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_match_infos_match_start ON match_infos (match_start) WHERE timeline_pruned = FALSE;
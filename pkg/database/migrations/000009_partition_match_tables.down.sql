@@ -0,0 +1,231 @@
+-- Moves the partitioned tables back to plain tables, without the match start.
+DROP VIEW IF EXISTS all_events;
+
+ALTER TABLE match_stats RENAME TO match_stats_partitioned;
+ALTER TABLE match_stats_partitioned RENAME CONSTRAINT match_stats_pkey TO match_stats_partitioned_pkey;
+ALTER SEQUENCE match_stats_id_seq OWNED BY NONE;
+
+ALTER TABLE participant_frames RENAME TO participant_frames_partitioned;
+ALTER TABLE participant_frames_partitioned RENAME CONSTRAINT participant_frames_pkey TO participant_frames_partitioned_pkey;
+
+DROP INDEX IF EXISTS idx_match_player;
+DROP INDEX IF EXISTS idx_match_stats_player;
+DROP INDEX IF EXISTS idx_match_participant;
+
+CREATE TABLE match_stats (
+    LIKE match_stats_partitioned INCLUDING DEFAULTS,
+    CONSTRAINT match_stats_pkey PRIMARY KEY (id),
+    CONSTRAINT fk_match_stats_match FOREIGN KEY (match_id) REFERENCES match_infos (id),
+    CONSTRAINT fk_match_stats_player FOREIGN KEY (player_id) REFERENCES player_infos (id)
+);
+ALTER TABLE match_stats DROP COLUMN match_start;
+ALTER SEQUENCE match_stats_id_seq OWNED BY match_stats.id;
+
+CREATE UNIQUE INDEX idx_match_player ON match_stats (match_id, player_id);
+CREATE INDEX idx_match_stats_player ON match_stats (player_id, match_id);
+
+CREATE TABLE participant_frames (
+    LIKE participant_frames_partitioned INCLUDING DEFAULTS,
+    CONSTRAINT participant_frames_pkey PRIMARY KEY (match_stat_id, frame_index),
+    CONSTRAINT fk_participant_frames_match_stat FOREIGN KEY (match_stat_id) REFERENCES match_stats (id)
+);
+ALTER TABLE participant_frames DROP COLUMN match_start;
+
+DO $$
+DECLARE
+    event_table TEXT;
+BEGIN
+    FOREACH event_table IN ARRAY ARRAY[
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I RENAME TO %I', event_table, event_table || '_partitioned');
+        EXECUTE format('DROP INDEX IF EXISTS %I', 'idx_' || event_table || '_match_id');
+        EXECUTE format('DROP INDEX IF EXISTS %I', 'idx_' || event_table || '_timestamp');
+        EXECUTE format(
+            'CREATE TABLE %I (
+                LIKE %I INCLUDING DEFAULTS,
+                CONSTRAINT %I FOREIGN KEY (match_id) REFERENCES match_infos (id)
+            )',
+            event_table, event_table || '_partitioned', 'fk_' || event_table || '_match_info'
+        );
+        EXECUTE format('ALTER TABLE %I DROP COLUMN match_start', event_table);
+    END LOOP;
+END $$;
+
+CREATE INDEX idx_event_feat_updates_match_id ON event_feat_updates (match_id);
+CREATE INDEX idx_event_items_timestamp ON event_items ("timestamp");
+CREATE INDEX idx_match_participant ON event_items (match_id, participant_id);
+CREATE INDEX idx_event_kill_structs_timestamp ON event_kill_structs ("timestamp");
+CREATE INDEX idx_event_level_ups_timestamp ON event_level_ups ("timestamp");
+CREATE INDEX idx_event_monster_kills_timestamp ON event_monster_kills ("timestamp");
+CREATE INDEX idx_event_player_kills_timestamp ON event_player_kills ("timestamp");
+CREATE INDEX idx_event_skill_level_ups_timestamp ON event_skill_level_ups ("timestamp");
+CREATE INDEX idx_event_wards_timestamp ON event_wards ("timestamp");
+
+-- Copy the data back, every column but the match start.
+DO $$
+DECLARE
+    plain_table TEXT;
+    column_list TEXT;
+BEGIN
+    FOREACH plain_table IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        SELECT string_agg(quote_ident(column_name), ', ' ORDER BY ordinal_position)
+        INTO column_list
+        FROM information_schema.columns
+        WHERE table_schema = current_schema() AND table_name = plain_table;
+
+        EXECUTE format(
+            'INSERT INTO %I (%s) SELECT %s FROM %I',
+            plain_table, column_list, column_list, plain_table || '_partitioned'
+        );
+    END LOOP;
+
+    -- Frames first, they reference the stats.
+    FOREACH plain_table IN ARRAY ARRAY[
+        'participant_frames',
+        'match_stats',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        EXECUTE format('DROP TABLE %I', plain_table || '_partitioned');
+    END LOOP;
+END $$;
+
+DROP FUNCTION IF EXISTS create_match_partitions(DATE);
+DROP FUNCTION IF EXISTS create_monthly_partition(TEXT, DATE);
+
+CREATE VIEW all_events AS
+    SELECT 
+        match_id,
+        timestamp,
+        'feat_update' as event_type,
+        NULL::bigint as participant_id,
+        json_build_object(
+            'feat_type', feat_type,
+            'feat_value', feat_value,
+            'team_id',team_id
+        ) as data
+    FROM event_feat_updates
+
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'item' as event_type,
+        participant_id,
+        json_build_object(
+            'item_id', item_id,
+            'after_id', after_id,
+            'action', action
+        ) as data
+    FROM event_items
+
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'kill_struct' as event_type,
+        participant_id,
+        json_build_object(
+            'building_type', building_type,
+            'event_type', event_type,
+            'lane_type', lane_type,
+            'team_id',team_id,
+            'tower_type', tower_type,
+            'x',x,
+            'y',y
+        ) as data
+    FROM event_kill_structs
+
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'level_up' as event_type,
+        participant_id,
+        json_build_object(
+            'level', level
+        ) as data
+    FROM event_level_ups
+
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'monster_kill' as event_type,
+        participant_id,
+        json_build_object(
+            'monster_type', monster_type,
+            'team_id',killer_team ,
+        	'x',x,
+        	'y',y
+        ) as data
+    FROM event_monster_kills emk
+
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'player_kill' as event_type,
+        participant_id,
+        json_build_object(
+        	'victim_participant_id',victim_participant_id ,
+        	'x',x,
+        	'y',y
+        ) as data
+    FROM event_player_kills 
+    
+    UNION ALL
+
+    SELECT 
+        match_id,
+        timestamp,
+        'skill_level_up' as event_type,
+        participant_id,
+        json_build_object(
+            'level_up_type', level_up_type,
+            'skill_slot', skill_slot
+        ) as data
+    FROM event_skill_level_ups
+
+    UNION ALL
+
+    SELECT
+	    match_id,
+	    timestamp,
+	    'ward' AS event_type,
+	    participant_id,
+	    json_build_object(
+            'event_type', event_type,
+            'ward_type', ward_type
+        ) AS DATA
+    FROM event_wards;
@@ -0,0 +1,315 @@
+-- Partitions the match stats, participant frames and event tables by the month of the match start.
+-- The partition key must be on every table, so the match start is copied from the match information.
+-- Rows without a valid match start land on the default partitions.
+DROP VIEW IF EXISTS all_events;
+
+-- Creates the partition of a table for the month of a given date.
+CREATE OR REPLACE FUNCTION create_monthly_partition(parent TEXT, month_date DATE)
+RETURNS VOID AS $$
+DECLARE
+    partition_name TEXT := parent || '_' || to_char(month_date, 'YYYY_MM');
+    range_start TIMESTAMPTZ := date_trunc('month', month_date::TIMESTAMP) AT TIME ZONE 'UTC';
+    range_end TIMESTAMPTZ := (date_trunc('month', month_date::TIMESTAMP) + INTERVAL '1 month') AT TIME ZONE 'UTC';
+BEGIN
+    EXECUTE format(
+        'CREATE TABLE IF NOT EXISTS %I PARTITION OF %I FOR VALUES FROM (%L) TO (%L)',
+        partition_name, parent, range_start, range_end
+    );
+END;
+$$ LANGUAGE plpgsql;
+
+-- Creates the partitions of every match table for the month of a given date.
+-- Called by the scheduler ahead of time, so the rows never go to the default partitions.
+CREATE OR REPLACE FUNCTION create_match_partitions(month_date DATE)
+RETURNS VOID AS $$
+DECLARE
+    parent TEXT;
+BEGIN
+    FOREACH parent IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        PERFORM create_monthly_partition(parent, month_date);
+    END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Move the current tables out of the way, the indexes are recreated on the partitioned tables.
+ALTER TABLE match_stats RENAME TO match_stats_old;
+ALTER TABLE match_stats_old RENAME CONSTRAINT match_stats_pkey TO match_stats_old_pkey;
+ALTER SEQUENCE match_stats_id_seq OWNED BY NONE;
+
+ALTER TABLE participant_frames RENAME TO participant_frames_old;
+ALTER TABLE participant_frames_old RENAME CONSTRAINT participant_frames_pkey TO participant_frames_old_pkey;
+
+ALTER TABLE event_feat_updates RENAME TO event_feat_updates_old;
+ALTER TABLE event_items RENAME TO event_items_old;
+ALTER TABLE event_kill_structs RENAME TO event_kill_structs_old;
+ALTER TABLE event_level_ups RENAME TO event_level_ups_old;
+ALTER TABLE event_monster_kills RENAME TO event_monster_kills_old;
+ALTER TABLE event_player_kills RENAME TO event_player_kills_old;
+ALTER TABLE event_skill_level_ups RENAME TO event_skill_level_ups_old;
+ALTER TABLE event_wards RENAME TO event_wards_old;
+
+DROP INDEX IF EXISTS idx_match_player;
+DROP INDEX IF EXISTS idx_match_stats_player;
+DROP INDEX IF EXISTS idx_event_feat_updates_match_id;
+DROP INDEX IF EXISTS idx_event_items_timestamp;
+DROP INDEX IF EXISTS idx_match_participant;
+DROP INDEX IF EXISTS idx_event_kill_structs_timestamp;
+DROP INDEX IF EXISTS idx_event_level_ups_timestamp;
+DROP INDEX IF EXISTS idx_event_monster_kills_timestamp;
+DROP INDEX IF EXISTS idx_event_player_kills_timestamp;
+DROP INDEX IF EXISTS idx_event_skill_level_ups_timestamp;
+DROP INDEX IF EXISTS idx_event_wards_timestamp;
+
+-- Match statistics.
+CREATE TABLE match_stats (
+    LIKE match_stats_old INCLUDING DEFAULTS,
+    match_start TIMESTAMPTZ NOT NULL,
+    CONSTRAINT match_stats_pkey PRIMARY KEY (id, match_start),
+    CONSTRAINT fk_match_stats_match FOREIGN KEY (match_id) REFERENCES match_infos (id),
+    CONSTRAINT fk_match_stats_player FOREIGN KEY (player_id) REFERENCES player_infos (id)
+) PARTITION BY RANGE (match_start);
+
+ALTER SEQUENCE match_stats_id_seq OWNED BY match_stats.id;
+
+CREATE UNIQUE INDEX idx_match_player ON match_stats (match_id, player_id, match_start);
+CREATE INDEX idx_match_stats_player ON match_stats (player_id, match_id);
+
+-- Participant frames.
+CREATE TABLE participant_frames (
+    LIKE participant_frames_old INCLUDING DEFAULTS,
+    match_start TIMESTAMPTZ NOT NULL,
+    CONSTRAINT participant_frames_pkey PRIMARY KEY (match_stat_id, frame_index, match_start),
+    CONSTRAINT fk_participant_frames_match_stat FOREIGN KEY (match_stat_id, match_start) REFERENCES match_stats (id, match_start)
+) PARTITION BY RANGE (match_start);
+
+-- Events, every table is looked up by the match.
+DO $$
+DECLARE
+    event_table TEXT;
+BEGIN
+    FOREACH event_table IN ARRAY ARRAY[
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        EXECUTE format(
+            'CREATE TABLE %I (
+                LIKE %I INCLUDING DEFAULTS,
+                match_start TIMESTAMPTZ NOT NULL,
+                CONSTRAINT %I FOREIGN KEY (match_id) REFERENCES match_infos (id)
+            ) PARTITION BY RANGE (match_start)',
+            event_table, event_table || '_old', 'fk_' || event_table || '_match_info'
+        );
+        EXECUTE format('CREATE INDEX %I ON %I (match_id)', 'idx_' || event_table || '_match_id', event_table);
+        EXECUTE format('CREATE INDEX %I ON %I ("timestamp")', 'idx_' || event_table || '_timestamp', event_table);
+    END LOOP;
+END $$;
+
+CREATE INDEX idx_match_participant ON event_items (match_id, participant_id);
+
+-- Create the partitions from the first match until two months ahead, plus the default ones.
+DO $$
+DECLARE
+    parent TEXT;
+    first_month DATE;
+    current_month DATE;
+BEGIN
+    FOREACH parent IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        EXECUTE format('CREATE TABLE %I PARTITION OF %I DEFAULT', parent || '_default', parent);
+    END LOOP;
+
+    SELECT date_trunc('month', MIN(match_start) AT TIME ZONE 'UTC')::DATE
+    INTO first_month
+    FROM match_infos
+    WHERE match_start >= '2010-01-01';
+
+    current_month := COALESCE(first_month, date_trunc('month', NOW() AT TIME ZONE 'UTC')::DATE);
+    WHILE current_month <= (date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '2 months')::DATE LOOP
+        PERFORM create_match_partitions(current_month);
+        current_month := (current_month + INTERVAL '1 month')::DATE;
+    END LOOP;
+END $$;
+
+-- Copy the data with the match start of each match.
+INSERT INTO match_stats
+SELECT ms.*, COALESCE(mi.match_start, 'epoch')
+FROM match_stats_old ms
+JOIN match_infos mi ON mi.id = ms.match_id;
+
+INSERT INTO participant_frames
+SELECT pf.*, ms.match_start
+FROM participant_frames_old pf
+JOIN match_stats ms ON ms.id = pf.match_stat_id;
+
+DO $$
+DECLARE
+    event_table TEXT;
+BEGIN
+    FOREACH event_table IN ARRAY ARRAY[
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        EXECUTE format(
+            'INSERT INTO %I SELECT e.*, COALESCE(mi.match_start, %L) FROM %I e JOIN match_infos mi ON mi.id = e.match_id',
+            event_table, 'epoch', event_table || '_old'
+        );
+        EXECUTE format('DROP TABLE %I', event_table || '_old');
+    END LOOP;
+END $$;
+
+DROP TABLE participant_frames_old;
+DROP TABLE match_stats_old;
+
+-- Same view, exposing the match start for the partition pruning.
+CREATE VIEW all_events AS
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'feat_update' as event_type,
+        NULL::bigint as participant_id,
+        json_build_object(
+            'feat_type', feat_type,
+            'feat_value', feat_value,
+            'team_id',team_id
+        ) as data
+    FROM event_feat_updates
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'item' as event_type,
+        participant_id,
+        json_build_object(
+            'item_id', item_id,
+            'after_id', after_id,
+            'action', action
+        ) as data
+    FROM event_items
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'kill_struct' as event_type,
+        participant_id,
+        json_build_object(
+            'building_type', building_type,
+            'event_type', event_type,
+            'lane_type', lane_type,
+            'team_id',team_id,
+            'tower_type', tower_type,
+            'x',x,
+            'y',y
+        ) as data
+    FROM event_kill_structs
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'level_up' as event_type,
+        participant_id,
+        json_build_object(
+            'level', level
+        ) as data
+    FROM event_level_ups
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'monster_kill' as event_type,
+        participant_id,
+        json_build_object(
+            'monster_type', monster_type,
+            'team_id',killer_team ,
+            'x',x,
+            'y',y
+        ) as data
+    FROM event_monster_kills
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'player_kill' as event_type,
+        participant_id,
+        json_build_object(
+            'victim_participant_id',victim_participant_id ,
+            'x',x,
+            'y',y
+        ) as data
+    FROM event_player_kills
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'skill_level_up' as event_type,
+        participant_id,
+        json_build_object(
+            'level_up_type', level_up_type,
+            'skill_slot', skill_slot
+        ) as data
+    FROM event_skill_level_ups
+
+    UNION ALL
+
+    SELECT
+        match_id,
+        match_start,
+        timestamp,
+        'ward' AS event_type,
+        participant_id,
+        json_build_object(
+            'event_type', event_type,
+            'ward_type', ward_type
+        ) AS DATA
+    FROM event_wards;
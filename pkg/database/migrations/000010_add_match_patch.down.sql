@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_match_infos_patch;
+
+ALTER TABLE match_infos
+DROP COLUMN IF EXISTS patch;
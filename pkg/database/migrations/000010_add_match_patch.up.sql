@@ -0,0 +1,10 @@
+ALTER TABLE match_infos
+ADD COLUMN IF NOT EXISTS patch VARCHAR(10);
+
+-- Backfill from the game version, like 15.19.712.2108 to 15.19.
+UPDATE match_infos
+SET patch = split_part(game_version, '.', 1) || '.' || split_part(game_version, '.', 2)
+WHERE patch IS NULL
+AND game_version ~ '^[0-9]{1,3}\.[0-9]{1,3}(\.|$)';
+
+CREATE INDEX IF NOT EXISTS idx_match_infos_patch ON match_infos (patch);
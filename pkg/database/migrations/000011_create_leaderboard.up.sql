@@ -0,0 +1,56 @@
+-- Ladder of the latest rating of each player, refreshed by the scheduler.
+-- The previous position uses the latest ratings before the current day, for the daily rank change.
+CREATE MATERIALIZED VIEW IF NOT EXISTS leaderboard_entries AS
+WITH latest AS (
+    SELECT DISTINCT ON (player_id, queue)
+        player_id,
+        queue,
+        region,
+        tier,
+        rank,
+        league_points,
+        numeric_score,
+        wins,
+        losses,
+        fetch_time
+    FROM rating_entries
+    ORDER BY player_id, queue, fetch_time DESC, id DESC
+),
+previous AS (
+    SELECT DISTINCT ON (player_id, queue)
+        player_id,
+        queue,
+        region,
+        numeric_score,
+        wins
+    FROM rating_entries
+    WHERE fetch_time < date_trunc('day', NOW() AT TIME ZONE 'UTC') AT TIME ZONE 'UTC'
+    ORDER BY player_id, queue, fetch_time DESC, id DESC
+),
+previous_positions AS (
+    SELECT
+        player_id,
+        queue,
+        ROW_NUMBER() OVER (PARTITION BY region, queue ORDER BY numeric_score DESC, wins DESC, player_id) AS position
+    FROM previous
+)
+SELECT
+    l.player_id,
+    l.queue,
+    l.region,
+    l.tier,
+    l.rank,
+    l.league_points,
+    l.numeric_score,
+    l.wins,
+    l.losses,
+    l.fetch_time,
+    ROW_NUMBER() OVER (PARTITION BY l.region, l.queue ORDER BY l.numeric_score DESC, l.wins DESC, l.player_id) AS position,
+    pp.position AS previous_position
+FROM latest l
+LEFT JOIN previous_positions pp ON pp.player_id = l.player_id AND pp.queue = l.queue;
+
+-- The unique index allows the concurrent refreshes, the reads are never blocked.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_leaderboard_entries_player ON leaderboard_entries (player_id, queue);
+CREATE INDEX IF NOT EXISTS idx_leaderboard_entries_position ON leaderboard_entries (region, queue, position);
+CREATE INDEX IF NOT EXISTS idx_leaderboard_entries_tier ON leaderboard_entries (region, queue, tier, position);
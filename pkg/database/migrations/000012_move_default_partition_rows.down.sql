@@ -0,0 +1,22 @@
+-- Restores the partition creation without moving the rows of the default partitions.
+CREATE OR REPLACE FUNCTION create_match_partitions(month_date DATE)
+RETURNS VOID AS $$
+DECLARE
+    parent TEXT;
+BEGIN
+    FOREACH parent IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        PERFORM create_monthly_partition(parent, month_date);
+    END LOOP;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,106 @@
+-- A month partition can't be created while the default partition holds rows of that month,
+-- so the rows are moved out of the default partitions before the partitions are created.
+-- Only the missing partitions move rows, the months created ahead by the scheduler return early.
+CREATE OR REPLACE FUNCTION create_match_partitions(month_date DATE)
+RETURNS VOID AS $$
+DECLARE
+    parent TEXT;
+    missing TEXT[] := ARRAY[]::TEXT[];
+    range_start TIMESTAMPTZ := date_trunc('month', month_date::TIMESTAMP) AT TIME ZONE 'UTC';
+    range_end TIMESTAMPTZ := (date_trunc('month', month_date::TIMESTAMP) + INTERVAL '1 month') AT TIME ZONE 'UTC';
+BEGIN
+    FOREACH parent IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        IF to_regclass(parent || '_' || to_char(month_date, 'YYYY_MM')) IS NULL THEN
+            missing := array_append(missing, parent);
+        END IF;
+    END LOOP;
+
+    IF cardinality(missing) = 0 THEN
+        RETURN;
+    END IF;
+
+    -- The frames reference the stats, so they are moved out first.
+    FOREACH parent IN ARRAY ARRAY[
+        'participant_frames',
+        'match_stats',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        CONTINUE WHEN NOT parent = ANY(missing);
+
+        EXECUTE format(
+            'CREATE TEMP TABLE %I AS SELECT * FROM %I WHERE match_start >= %L AND match_start < %L',
+            parent || '_moved', parent || '_default', range_start, range_end
+        );
+        EXECUTE format(
+            'DELETE FROM %I WHERE match_start >= %L AND match_start < %L',
+            parent || '_default', range_start, range_end
+        );
+    END LOOP;
+
+    FOREACH parent IN ARRAY ARRAY[
+        'match_stats',
+        'participant_frames',
+        'event_feat_updates',
+        'event_items',
+        'event_kill_structs',
+        'event_level_ups',
+        'event_monster_kills',
+        'event_player_kills',
+        'event_skill_level_ups',
+        'event_wards'
+    ] LOOP
+        CONTINUE WHEN NOT parent = ANY(missing);
+
+        PERFORM create_monthly_partition(parent, month_date);
+        EXECUTE format('INSERT INTO %I SELECT * FROM %I', parent, parent || '_moved');
+        EXECUTE format('DROP TABLE %I', parent || '_moved');
+    END LOOP;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Create the partitions of the months already stored on the default partitions.
+-- The frames always belong to stored stats, so their months are already covered.
+DO $$
+DECLARE
+    month_date DATE;
+BEGIN
+    FOR month_date IN
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM match_stats_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_feat_updates_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_items_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_kill_structs_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_level_ups_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_monster_kills_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_player_kills_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_skill_level_ups_default
+        UNION
+        SELECT date_trunc('month', match_start AT TIME ZONE 'UTC')::DATE FROM event_wards_default
+    LOOP
+        PERFORM create_match_partitions(month_date);
+    END LOOP;
+END $$;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_match_infos_region;
+
+ALTER TABLE match_infos
+DROP COLUMN IF EXISTS region;
@@ -0,0 +1,10 @@
+ALTER TABLE match_infos
+ADD COLUMN IF NOT EXISTS region VARCHAR(10);
+
+-- Backfill from the match id, prefixed by the sub region like BR1_123.
+UPDATE match_infos
+SET region = split_part(match_id, '_', 1)
+WHERE region IS NULL
+AND match_id LIKE '%\_%';
+
+CREATE INDEX IF NOT EXISTS idx_match_infos_region ON match_infos (region);
@@ -0,0 +1,23 @@
+package models
+
+import (
+	"goleague/pkg/regions"
+	"time"
+)
+
+// LeagueCrawlCursor is the last page fetched for a given league on a sub region.
+// Used for resuming the league pagination after a restart.
+type LeagueCrawlCursor struct {
+	Region      regions.SubRegion `gorm:"primaryKey;type:varchar(10)"`
+	Queue       string            `gorm:"primaryKey;type:varchar(30)"`
+	Tier        string            `gorm:"primaryKey;type:varchar(20)"`
+	Rank        string            `gorm:"primaryKey;type:varchar(5)"`
+	CurrentPage int
+	UpdatedAt   time.Time
+}
+
+// LeagueCrawlCycle holds when a sub region finished its last full league cycle.
+type LeagueCrawlCycle struct {
+	Region          regions.SubRegion `gorm:"primaryKey;type:varchar(10)"`
+	LastCompletedAt time.Time
+}
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"goleague/pkg/regions"
+	"time"
+)
+
+// Reasons of a match being rejected during the ingestion.
+const (
+	FailureMatchFetch      = "match_fetch"
+	FailureMatchProcess    = "match_process"
+	FailureTimelineFetch   = "timeline_fetch"
+	FailureTimelineProcess = "timeline_process"
+	FailureUntreatedQueue  = "untreated_queue"
+)
+
+// FailedMatch is a match that couldn't be ingested.
+// Matches are skipped while permanent or before the next retry.
+type FailedMatch struct {
+	MatchId     string            `gorm:"primaryKey;type:varchar(20)"`
+	Region      regions.SubRegion `gorm:"type:varchar(10)"`
+	Reason      string            `gorm:"type:varchar(30)"`
+	LastError   string
+	Attempts    int
+	Permanent   bool
+	NextRetryAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -8,7 +8,9 @@ import (
 type MatchInfo struct {
 	ID             uint   `gorm:"primaryKey"`
 	GameVersion    string `gorm:"type:varchar(20)"`
+	Patch          string `gorm:"type:varchar(10);index"`
 	MatchId        string `gorm:"type:varchar(20);uniqueIndex"`
+	Region         string `gorm:"type:varchar(10);index"`
 	MatchStart     time.Time
 	MatchDuration  int
 	MatchWinner    int
@@ -17,6 +19,7 @@ type MatchInfo struct {
 	AverageRating  float64 `gorm:"index"`
 	FrameInterval  int64
 	FullyFetched   bool
+	TimelinePruned bool
 	QueueId        int       `gorm:"index"`
 	CreatedAt      time.Time `gorm:"autoCreateTime"`
 }
@@ -28,6 +31,9 @@ type MatchStats struct {
 	MatchId  uint   `gorm:"not null;index:idx_match_player,unique"`
 	PlayerId uint   `gorm:"not null;index:idx_match_player,unique"`
 
+	// Partition key, copied from the match.
+	MatchStart time.Time `gorm:"not null;index:idx_match_player,unique"`
+
 	// Foreign keys.
 	Match  MatchInfo  `gorm:"MatchId"`
 	Player PlayerInfo `gorm:"PlayerId"`
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"goleague/pkg/regions"
+	"time"
+
 	"gorm.io/datatypes"
 )
 
@@ -8,8 +11,9 @@ import (
 
 // EventBase is the base structure for all events.
 type EventBase struct {
-	MatchID   uint      `gorm:"index:idx_match_participant;not null"`
-	MatchInfo MatchInfo `gorm:"foreignKey:MatchID"`
+	MatchID    uint      `gorm:"index:idx_match_participant;not null"`
+	MatchInfo  MatchInfo `gorm:"foreignKey:MatchID"`
+	MatchStart time.Time `gorm:"not null"` // Partition key, copied from the match.
 
 	ParticipantID *int  `gorm:"index:idx_match_participant"` // Nullable, some events can be made by non-participants (like minions).
 	Timestamp     int64 `gorm:"index;not null"`
@@ -17,8 +21,9 @@ type EventBase struct {
 
 // EventFeatUpdate contains data regarding feats of strength.
 type EventFeatUpdate struct {
-	MatchID   uint      `gorm:"index"`
-	MatchInfo MatchInfo `gorm:"foreignKey:MatchID"`
+	MatchID    uint      `gorm:"index"`
+	MatchInfo  MatchInfo `gorm:"foreignKey:MatchID"`
+	MatchStart time.Time `gorm:"not null"`
 
 	Timestamp int64
 
@@ -100,8 +105,9 @@ type EventWard struct {
 // Can be used for generating graphs.
 type ParticipantFrame struct {
 	// Composite primary key, a given player in a given match can have multiple frames.
-	MatchStatId uint64 `gorm:"primaryKey"`
-	FrameIndex  int    `gorm:"primaryKey"`
+	MatchStatId uint64    `gorm:"primaryKey"`
+	FrameIndex  int       `gorm:"primaryKey"`
+	MatchStart  time.Time `gorm:"primaryKey"` // Partition key, copied from the match.
 
 	// Foreign Key.
 	MatchStat MatchStats `gorm:"MatchStatId"`
@@ -139,3 +145,10 @@ type AllEvents struct {
 func (AllEvents) TableName() string {
 	return "all_events"
 }
+
+// PendingTimeline is a match whose timeline was deferred, fetched only when the match is viewed.
+type PendingTimeline struct {
+	MatchId   uint              `gorm:"primaryKey;autoIncrement:false"`
+	Region    regions.SubRegion `gorm:"type:varchar(10)"`
+	CreatedAt time.Time
+}
@@ -218,6 +218,103 @@ func (x *MatchHistoryFetchNotification) GetWillProcess() bool {
 	return false
 }
 
+type MatchTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=matchId,proto3" json:"matchId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTimelineRequest) Reset() {
+	*x = MatchTimelineRequest{}
+	mi := &file_pkg_grpc_services_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTimelineRequest) ProtoMessage() {}
+
+func (x *MatchTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_services_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTimelineRequest.ProtoReflect.Descriptor instead.
+func (*MatchTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_services_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MatchTimelineRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+// Result of a deferred timeline fetch.
+type MatchTimelineNotification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Fetched       bool                   `protobuf:"varint,2,opt,name=fetched,proto3" json:"fetched,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTimelineNotification) Reset() {
+	*x = MatchTimelineNotification{}
+	mi := &file_pkg_grpc_services_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTimelineNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTimelineNotification) ProtoMessage() {}
+
+func (x *MatchTimelineNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_grpc_services_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTimelineNotification.ProtoReflect.Descriptor instead.
+func (*MatchTimelineNotification) Descriptor() ([]byte, []int) {
+	return file_pkg_grpc_services_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MatchTimelineNotification) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MatchTimelineNotification) GetFetched() bool {
+	if x != nil {
+		return x.Fetched
+	}
+	return false
+}
+
 var File_pkg_grpc_services_proto protoreflect.FileDescriptor
 
 const file_pkg_grpc_services_proto_rawDesc = "" +
@@ -236,10 +333,16 @@ const file_pkg_grpc_services_proto_rawDesc = "" +
 	"\rprofileIconId\x18\x06 \x01(\x05R\rprofileIconId\"[\n" +
 	"\x1dMatchHistoryFetchNotification\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x12 \n" +
-	"\vwillProcess\x18\x02 \x01(\bR\vwillProcess2\x9a\x01\n" +
+	"\vwillProcess\x18\x02 \x01(\bR\vwillProcess\"0\n" +
+	"\x14MatchTimelineRequest\x12\x18\n" +
+	"\amatchId\x18\x01 \x01(\tR\amatchId\"O\n" +
+	"\x19MatchTimelineNotification\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x18\n" +
+	"\afetched\x18\x02 \x01(\bR\afetched2\xef\x01\n" +
 	"\aService\x12<\n" +
 	"\x11FetchSummonerData\x12\x15.grpc.SummonerRequest\x1a\x0e.grpc.Summoner\"\x00\x12Q\n" +
-	"\x11FetchMatchHistory\x12\x15.grpc.SummonerRequest\x1a#.grpc.MatchHistoryFetchNotification\"\x00B\x13Z\x11goleague/pkg/grpcb\x06proto3"
+	"\x11FetchMatchHistory\x12\x15.grpc.SummonerRequest\x1a#.grpc.MatchHistoryFetchNotification\"\x00\x12S\n" +
+	"\x12FetchMatchTimeline\x12\x1a.grpc.MatchTimelineRequest\x1a\x1f.grpc.MatchTimelineNotification\"\x00B\x13Z\x11goleague/pkg/grpcb\x06proto3"
 
 var (
 	file_pkg_grpc_services_proto_rawDescOnce sync.Once
@@ -253,19 +356,23 @@ func file_pkg_grpc_services_proto_rawDescGZIP() []byte {
 	return file_pkg_grpc_services_proto_rawDescData
 }
 
-var file_pkg_grpc_services_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_grpc_services_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_pkg_grpc_services_proto_goTypes = []any{
 	(*SummonerRequest)(nil),               // 0: grpc.SummonerRequest
 	(*Summoner)(nil),                      // 1: grpc.Summoner
 	(*MatchHistoryFetchNotification)(nil), // 2: grpc.MatchHistoryFetchNotification
+	(*MatchTimelineRequest)(nil),          // 3: grpc.MatchTimelineRequest
+	(*MatchTimelineNotification)(nil),     // 4: grpc.MatchTimelineNotification
 }
 var file_pkg_grpc_services_proto_depIdxs = []int32{
 	0, // 0: grpc.Service.FetchSummonerData:input_type -> grpc.SummonerRequest
 	0, // 1: grpc.Service.FetchMatchHistory:input_type -> grpc.SummonerRequest
-	1, // 2: grpc.Service.FetchSummonerData:output_type -> grpc.Summoner
-	2, // 3: grpc.Service.FetchMatchHistory:output_type -> grpc.MatchHistoryFetchNotification
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	3, // 2: grpc.Service.FetchMatchTimeline:input_type -> grpc.MatchTimelineRequest
+	1, // 3: grpc.Service.FetchSummonerData:output_type -> grpc.Summoner
+	2, // 4: grpc.Service.FetchMatchHistory:output_type -> grpc.MatchHistoryFetchNotification
+	4, // 5: grpc.Service.FetchMatchTimeline:output_type -> grpc.MatchTimelineNotification
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -282,7 +389,7 @@ func file_pkg_grpc_services_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_grpc_services_proto_rawDesc), len(file_pkg_grpc_services_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
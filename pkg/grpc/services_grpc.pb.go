@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Service_FetchSummonerData_FullMethodName = "/grpc.Service/FetchSummonerData"
-	Service_FetchMatchHistory_FullMethodName = "/grpc.Service/FetchMatchHistory"
+	Service_FetchSummonerData_FullMethodName  = "/grpc.Service/FetchSummonerData"
+	Service_FetchMatchHistory_FullMethodName  = "/grpc.Service/FetchMatchHistory"
+	Service_FetchMatchTimeline_FullMethodName = "/grpc.Service/FetchMatchTimeline"
 )
 
 // ServiceClient is the client API for Service service.
@@ -31,6 +32,7 @@ const (
 type ServiceClient interface {
 	FetchSummonerData(ctx context.Context, in *SummonerRequest, opts ...grpc.CallOption) (*Summoner, error)
 	FetchMatchHistory(ctx context.Context, in *SummonerRequest, opts ...grpc.CallOption) (*MatchHistoryFetchNotification, error)
+	FetchMatchTimeline(ctx context.Context, in *MatchTimelineRequest, opts ...grpc.CallOption) (*MatchTimelineNotification, error)
 }
 
 type serviceClient struct {
@@ -61,6 +63,16 @@ func (c *serviceClient) FetchMatchHistory(ctx context.Context, in *SummonerReque
 	return out, nil
 }
 
+func (c *serviceClient) FetchMatchTimeline(ctx context.Context, in *MatchTimelineRequest, opts ...grpc.CallOption) (*MatchTimelineNotification, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchTimelineNotification)
+	err := c.cc.Invoke(ctx, Service_FetchMatchTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ServiceServer is the server API for Service service.
 // All implementations must embed UnimplementedServiceServer
 // for forward compatibility.
@@ -69,6 +81,7 @@ func (c *serviceClient) FetchMatchHistory(ctx context.Context, in *SummonerReque
 type ServiceServer interface {
 	FetchSummonerData(context.Context, *SummonerRequest) (*Summoner, error)
 	FetchMatchHistory(context.Context, *SummonerRequest) (*MatchHistoryFetchNotification, error)
+	FetchMatchTimeline(context.Context, *MatchTimelineRequest) (*MatchTimelineNotification, error)
 	mustEmbedUnimplementedServiceServer()
 }
 
@@ -85,6 +98,9 @@ func (UnimplementedServiceServer) FetchSummonerData(context.Context, *SummonerRe
 func (UnimplementedServiceServer) FetchMatchHistory(context.Context, *SummonerRequest) (*MatchHistoryFetchNotification, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchMatchHistory not implemented")
 }
+func (UnimplementedServiceServer) FetchMatchTimeline(context.Context, *MatchTimelineRequest) (*MatchTimelineNotification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchMatchTimeline not implemented")
+}
 func (UnimplementedServiceServer) mustEmbedUnimplementedServiceServer() {}
 func (UnimplementedServiceServer) testEmbeddedByValue()                 {}
 
@@ -142,6 +158,24 @@ func _Service_FetchMatchHistory_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Service_FetchMatchTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceServer).FetchMatchTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Service_FetchMatchTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceServer).FetchMatchTimeline(ctx, req.(*MatchTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Service_ServiceDesc is the grpc.ServiceDesc for Service service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +191,10 @@ var Service_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchMatchHistory",
 			Handler:    _Service_FetchMatchHistory_Handler,
 		},
+		{
+			MethodName: "FetchMatchTimeline",
+			Handler:    _Service_FetchMatchTimeline_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/grpc/services.proto",
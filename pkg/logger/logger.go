@@ -1,135 +1,140 @@
 package logger
 
 import (
-	"context"
 	"fmt"
 	appConfig "goleague/pkg/config"
+	"io"
+	"log/slog"
 	"os"
-	"sync"
-	"time"
-
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/credentials"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
-	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"strings"
 )
 
-// NewLogger is a very simple logging implementation.
-// Writes logs to a temporary file that is later sent to a Bucket and cleaned.
-type NewLogger struct {
-	mu           sync.Mutex
-	logFile      *os.File
-	filePath     string
-	bucketConfig appConfig.BucketConfig
-	printLogs    bool
-	writesNumber int
-}
+// Available output formats.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
 
-// CreateLogger creates a new temporary file and return the logger.
-func CreateLogger(config *appConfig.Config) (*NewLogger, error) {
-	f, err := os.CreateTemp("", "log-*.log")
-	if err != nil {
-		return nil, err
-	}
+// Available sinks.
+const (
+	SinkStdout = "stdout"
+	SinkFile   = "file"
+	SinkS3     = "s3"
+)
 
-	return &NewLogger{
-		bucketConfig: config.Bucket,
-		logFile:      f,
-		filePath:     f.Name(),
-		printLogs:    config.PrintLogs,
-		writesNumber: 0,
-	}, nil
+// Logger is the structured logger shared by every binary.
+// Wraps a slog logger, keeping track of the S3 sink, if enabled, for the uploads.
+type Logger struct {
+	*slog.Logger
+	bucket *bucketSink
 }
 
-// Infof writes information to a file.
-func (l *NewLogger) Infof(format string, args ...any) {
-	l.write("[INFO]", format, args...)
+// New creates a logger writing to the sinks of the configuration.
+// The attributes are added to every record, usually the service and region.
+func New(config *appConfig.Config, attrs ...any) (*Logger, error) {
+	return newLogger(config, true, attrs...)
 }
 
-// Errorf writes errors to a file.
-func (l *NewLogger) Errorf(format string, args ...any) {
-	l.write("[ERROR]", format, args...)
+// NewStreaming creates a logger that ignores the S3 sink.
+// Used by the binaries that never upload their logs, so the temporary file doesn't grow forever.
+func NewStreaming(config *appConfig.Config, attrs ...any) (*Logger, error) {
+	return newLogger(config, false, attrs...)
 }
 
-// EmptyLine writes a empty line to the file.
-func (l *NewLogger) EmptyLine() {
-	l.logFile.WriteString("\n")
-	if l.printLogs {
-		fmt.Print("\n")
+// newLogger builds the handler with the enabled sinks.
+func newLogger(config *appConfig.Config, withUpload bool, attrs ...any) (*Logger, error) {
+	level, err := parseLevel(config.Logging.Level)
+	if err != nil {
+		return nil, err
 	}
-}
 
-// write is the base method for writing data to the file.
-// Works with parallelism and adds the date to the logs.
-func (l *NewLogger) write(infoType string, format string, args ...any) {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-
-	l.writesNumber++
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	line := fmt.Sprintf("%-8s %s %s\n", infoType, timestamp, fmt.Sprintf(format, args...))
+	var writers []io.Writer
+	var bucket *bucketSink
+
+	for _, sink := range config.Logging.Sinks {
+		switch sink {
+		case SinkStdout:
+			writers = append(writers, os.Stdout)
+		case SinkFile:
+			writers = append(writers, sharedFileSink(config.Logging.File))
+		case SinkS3:
+			if !withUpload {
+				continue
+			}
+			bucket, err = newBucketSink(config.Bucket)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't create the s3 sink: %w", err)
+			}
+			writers = append(writers, bucket)
+		default:
+			return nil, fmt.Errorf("unknown log sink %s", sink)
+		}
+	}
 
-	if l.printLogs {
-		fmt.Print(line)
+	opts := &slog.HandlerOptions{Level: level}
+	output := io.MultiWriter(writers...)
+
+	var handler slog.Handler
+	switch config.Logging.Format {
+	case FormatJSON, "":
+		handler = slog.NewJSONHandler(output, opts)
+	case FormatText:
+		handler = slog.NewTextHandler(output, opts)
+	default:
+		return nil, fmt.Errorf("unknown log format %s", config.Logging.Format)
 	}
 
-	l.logFile.WriteString(line)
+	return &Logger{
+		Logger: slog.New(handler).With(attrs...),
+		bucket: bucket,
+	}, nil
 }
 
-// CleanFile is responsible for cleaning the file after it's sent for the bucket.
-func (l *NewLogger) CleanFile() {
-	l.mu.Lock()
-	defer l.mu.Unlock()
+// With returns a logger with the given attributes, sharing the same sinks.
+func (l *Logger) With(args ...any) *Logger {
+	return &Logger{
+		Logger: l.Logger.With(args...),
+		bucket: l.bucket,
+	}
+}
 
-	l.writesNumber = 0
-	l.logFile.Truncate(0)
+// GetNumberOfWrites return the amount of records waiting to be uploaded.
+// Always zero if the S3 sink is disabled.
+func (l *Logger) GetNumberOfWrites() int {
+	if l.bucket == nil {
+		return 0
+	}
 
-	l.logFile.Seek(0, 0)
+	return l.bucket.writes()
 }
 
-// GetNumberOfWrites return the total amount of writes.
-func (l *NewLogger) GetNumberOfWrites() int {
-	l.mu.Lock()
-	defer l.mu.Unlock()
-	return l.writesNumber
+// UploadToS3Bucket send the pending records to the bucket and clean them for reuse.
+// Does nothing if the S3 sink is disabled.
+func (l *Logger) UploadToS3Bucket(objectKey string) error {
+	if l.bucket == nil {
+		return nil
+	}
+
+	return l.bucket.upload(objectKey)
 }
 
-// UploadToS3Bucket send the temporary log to the bucket and clean the temporary file for reuse.
-func (l *NewLogger) UploadToS3Bucket(objectKey string) error {
-	if _, err := l.logFile.Seek(0, 0); err != nil {
-		return fmt.Errorf("failed to rewind file: %v", err)
+// CleanFile discards the records waiting to be uploaded.
+func (l *Logger) CleanFile() {
+	if l.bucket != nil {
+		l.bucket.clean()
 	}
+}
 
-	// Get the config.
-	cfg := aws.Config{
-		Region: l.bucketConfig.Region,
-		Credentials: aws.NewCredentialsCache(
-			credentials.NewStaticCredentialsProvider(
-				l.bucketConfig.AccessKey,
-				l.bucketConfig.AccessSecret,
-				"",
-			),
-		),
+// parseLevel converts the configured level, defaulting to info.
+func parseLevel(level string) (slog.Level, error) {
+	var parsed slog.Level
+	if level == "" {
+		return slog.LevelInfo, nil
 	}
 
-	// Create the client.
-	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
-		o.BaseEndpoint = aws.String(l.bucketConfig.Endpoint)
-	})
-
-	// Run the put.
-	_, err := s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
-		Bucket: aws.String(l.bucketConfig.LogBucket),
-		Key:    aws.String(objectKey),
-		Body:   l.logFile,
-		ACL:    types.ObjectCannedACLPrivate,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to upload %s to S3 bucket: %v", objectKey, err)
+	if err := parsed.UnmarshalText([]byte(strings.ToUpper(level))); err != nil {
+		return 0, fmt.Errorf("invalid log level %s: %w", level, err)
 	}
 
-	// Clean the file after sending.
-	l.CleanFile()
-
-	return nil
+	return parsed, nil
 }
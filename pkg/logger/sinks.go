@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	appConfig "goleague/pkg/config"
+	"os"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// Rotating files are shared between loggers, since two writers can't rotate the same file.
+var (
+	fileSinksMu sync.Mutex
+	fileSinks   = make(map[string]*lumberjack.Logger)
+)
+
+// sharedFileSink returns the rotating writer of a given path, creating it on the first call.
+func sharedFileSink(config appConfig.LogFileConfig) *lumberjack.Logger {
+	fileSinksMu.Lock()
+	defer fileSinksMu.Unlock()
+
+	if sink, ok := fileSinks[config.Path]; ok {
+		return sink
+	}
+
+	sink := &lumberjack.Logger{
+		Filename:   config.Path,
+		MaxSize:    config.MaxSizeMB,
+		MaxBackups: config.MaxBackups,
+		MaxAge:     config.MaxAgeDays,
+	}
+	fileSinks[config.Path] = sink
+
+	return sink
+}
+
+// bucketSink writes the records to a temporary file that is later sent to a bucket and cleaned.
+type bucketSink struct {
+	mu           sync.Mutex
+	logFile      *os.File
+	bucketConfig appConfig.BucketConfig
+	writesNumber int
+}
+
+// newBucketSink creates the temporary file of the sink.
+func newBucketSink(config appConfig.BucketConfig) (*bucketSink, error) {
+	f, err := os.CreateTemp("", "log-*.log")
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucketSink{
+		bucketConfig: config,
+		logFile:      f,
+	}, nil
+}
+
+// Write appends a record to the temporary file.
+func (b *bucketSink) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.writesNumber++
+	return b.logFile.Write(p)
+}
+
+// writes return the total amount of records since the last upload.
+func (b *bucketSink) writes() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.writesNumber
+}
+
+// clean truncates the temporary file.
+func (b *bucketSink) clean() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cleanLocked()
+}
+
+// cleanLocked truncates the file, must be called holding the lock.
+func (b *bucketSink) cleanLocked() {
+	b.writesNumber = 0
+	b.logFile.Truncate(0)
+	b.logFile.Seek(0, 0)
+}
+
+// upload send the temporary file to the bucket and clean it for reuse.
+// The writes are blocked during the upload, so no record is lost on the truncate.
+func (b *bucketSink) upload(objectKey string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, err := b.logFile.Seek(0, 0); err != nil {
+		return fmt.Errorf("failed to rewind file: %v", err)
+	}
+
+	// Get the config.
+	cfg := aws.Config{
+		Region: b.bucketConfig.Region,
+		Credentials: aws.NewCredentialsCache(
+			credentials.NewStaticCredentialsProvider(
+				b.bucketConfig.AccessKey,
+				b.bucketConfig.AccessSecret,
+				"",
+			),
+		),
+	}
+
+	// Create the client.
+	s3Client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		o.BaseEndpoint = aws.String(b.bucketConfig.Endpoint)
+	})
+
+	// Run the put.
+	_, err := s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket: aws.String(b.bucketConfig.LogBucket),
+		Key:    aws.String(objectKey),
+		Body:   b.logFile,
+		ACL:    types.ObjectCannedACLPrivate,
+	})
+	if err != nil {
+		// Go back to the end, so the next records aren't written over the pending ones.
+		b.logFile.Seek(0, 2)
+		return fmt.Errorf("failed to upload %s to S3 bucket: %v", objectKey, err)
+	}
+
+	// Clean the file after sending.
+	b.cleanLocked()
+
+	return nil
+}
@@ -7,4 +7,5 @@ const (
 	FiltersNotNil       = "filters can't be nil"
 	OperationInProgress = "operation already in progress, please wait"
 	RequestFailedMsg    = "API request failed on URL %s"
+	UnsupportedRegion   = "the region %s isn't supported"
 )
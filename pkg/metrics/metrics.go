@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Cache layers and results used as labels.
+const (
+	CacheLayerMemory = "memory"
+	CacheLayerRedis  = "redis"
+
+	CacheResultHit  = "hit"
+	CacheResultMiss = "miss"
+)
+
+// Riot API metrics.
+var (
+	RiotRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goleague_riot_requests_total",
+		Help: "Number of requests made to the Riot API.",
+	}, []string{"endpoint", "status"})
+
+	RiotRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_riot_request_duration_seconds",
+		Help:    "Latency of the requests made to the Riot API.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	RiotLimiterWait = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_riot_limiter_wait_seconds",
+		Help:    "Time spent waiting for the rate limiter before a Riot request.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"region", "limit"})
+)
+
+// Match ingestion metrics.
+var (
+	MatchesIngestedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goleague_matches_ingested_total",
+		Help: "Number of matches fully ingested.",
+	}, []string{"region", "queue"})
+
+	MatchFetchDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_match_fetch_duration_seconds",
+		Help:    "Time spent fetching the match data and timeline from the Riot API.",
+		Buckets: []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"region"})
+
+	MatchProcessDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_match_process_duration_seconds",
+		Help:    "Time spent processing and inserting the match data and timeline.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"region"})
+)
+
+// API metrics.
+var (
+	APIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_api_request_duration_seconds",
+		Help:    "Latency of the API routes.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	CacheRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goleague_cache_requests_total",
+		Help: "Number of cache lookups by cache, layer and result.",
+	}, []string{"cache", "layer", "result"})
+
+	QueryErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goleague_query_errors_total",
+		Help: "Number of failed database queries by the cache they were filling.",
+	}, []string{"cache"})
+)
+
+// Scheduler metrics.
+var (
+	JobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "goleague_job_duration_seconds",
+		Help:    "Duration of the scheduler jobs.",
+		Buckets: []float64{1, 5, 15, 30, 60, 300, 600, 1800, 3600},
+	}, []string{"job"})
+
+	JobRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "goleague_job_runs_total",
+		Help: "Number of scheduler job runs by outcome.",
+	}, []string{"job", "outcome"})
+)
+
+// CacheHit records a cache hit on a given layer.
+func CacheHit(cache string, layer string) {
+	CacheRequestsTotal.WithLabelValues(cache, layer, CacheResultHit).Inc()
+}
+
+// CacheMiss records a cache miss on a given layer.
+func CacheMiss(cache string, layer string) {
+	CacheRequestsTotal.WithLabelValues(cache, layer, CacheResultMiss).Inc()
+}
+
+// QueryError records a failed database query after every cache layer missed.
+func QueryError(cache string) {
+	QueryErrorsTotal.WithLabelValues(cache).Inc()
+}
+
+// ObserveSince records the elapsed time since start on a histogram.
+func ObserveSince(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+// Handler returns the HTTP handler that exposes the metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// StartServer exposes the metrics on a dedicated port.
+// Used by the binaries that don't have a HTTP server.
+func StartServer(port string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	go func() {
+		slog.Info("Starting the metrics server", "port", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("Metrics server failed", "error", err)
+		}
+	}()
+
+	return server
+}
@@ -18,4 +18,7 @@ type Item struct {
 	Plaintext   string      `json:"plaintext"`
 	Image       image.Image `json:"image"`
 	Gold        Gold        `json:"gold"`
+	From        []string    `json:"from,omitempty"`
+	Into        []string    `json:"into,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
 }
@@ -1,5 +1,10 @@
 package regions
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Simple package containing the region list.
 // Separated from the region manager to avoid import cycles.
 // Create the types for clarity.
@@ -8,10 +13,109 @@ type (
 	SubRegion  string
 )
 
-// List of regions.
+// List of regions supported by the Riot API.
+// Used as the source of truth for validating the configured regions.
 var RegionList = map[MainRegion][]SubRegion{
 	"AMERICAS": {"BR1", "LA1", "LA2", "NA1"},
 	"EUROPE":   {"EUN1", "EUW1", "TR1", "ME1", "RU"},
 	"ASIA":     {"KR", "JP1"},
 	"SEA":      {"OC1", "SG2", "TW2", "VN2"},
 }
+
+// RegionConfig holds the regions enabled for this deployment.
+type RegionConfig struct {
+	// Enabled sub regions grouped by their parent.
+	enabled map[MainRegion][]SubRegion
+
+	// Sub regions that are only fetched on demand, without background queues.
+	onDemandOnly map[SubRegion]bool
+}
+
+// NewRegionConfig creates the region configuration from a list of sub regions.
+// An empty enabled list means that every known region is enabled.
+func NewRegionConfig(enabled []string, onDemandOnly []string) (*RegionConfig, error) {
+	rc := &RegionConfig{
+		enabled:      make(map[MainRegion][]SubRegion),
+		onDemandOnly: make(map[SubRegion]bool),
+	}
+
+	if len(enabled) == 0 {
+		for mainRegion, subRegions := range RegionList {
+			rc.enabled[mainRegion] = append([]SubRegion(nil), subRegions...)
+		}
+	}
+
+	for _, region := range enabled {
+		subRegion := SubRegion(strings.ToUpper(strings.TrimSpace(region)))
+		mainRegion, ok := GetParentRegion(subRegion)
+		if !ok {
+			return nil, fmt.Errorf("unknown sub region %s in the enabled regions", region)
+		}
+
+		if !rc.IsEnabled(subRegion) {
+			rc.enabled[mainRegion] = append(rc.enabled[mainRegion], subRegion)
+		}
+	}
+
+	for _, region := range onDemandOnly {
+		subRegion := SubRegion(strings.ToUpper(strings.TrimSpace(region)))
+		if !rc.IsEnabled(subRegion) {
+			return nil, fmt.Errorf("on demand region %s must also be enabled", region)
+		}
+
+		rc.onDemandOnly[subRegion] = true
+	}
+
+	return rc, nil
+}
+
+// GetParentRegion returns the main region of a known sub region.
+func GetParentRegion(subRegion SubRegion) (MainRegion, bool) {
+	for mainRegion, subRegions := range RegionList {
+		for _, sr := range subRegions {
+			if sr == subRegion {
+				return mainRegion, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// Enabled returns the enabled sub regions grouped by main region.
+func (rc *RegionConfig) Enabled() map[MainRegion][]SubRegion {
+	return rc.enabled
+}
+
+// IsEnabled checks if a given sub region is enabled.
+func (rc *RegionConfig) IsEnabled(subRegion SubRegion) bool {
+	mainRegion, ok := GetParentRegion(subRegion)
+	if !ok {
+		return false
+	}
+
+	for _, sr := range rc.enabled[mainRegion] {
+		if sr == subRegion {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBackground checks if a sub region should have its background queues running.
+func (rc *RegionConfig) IsBackground(subRegion SubRegion) bool {
+	return rc.IsEnabled(subRegion) && !rc.onDemandOnly[subRegion]
+}
+
+// BackgroundSubRegions returns the sub regions of a main region that run the background queues.
+func (rc *RegionConfig) BackgroundSubRegions(mainRegion MainRegion) []SubRegion {
+	var subRegions []SubRegion
+	for _, subRegion := range rc.enabled[mainRegion] {
+		if !rc.onDemandOnly[subRegion] {
+			subRegions = append(subRegions, subRegion)
+		}
+	}
+
+	return subRegions
+}
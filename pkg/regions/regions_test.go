@@ -0,0 +1,125 @@
+package regions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRegionConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		enabled      []string
+		onDemandOnly []string
+
+		expectedEnabled map[MainRegion][]SubRegion
+		expectedError   string
+	}{
+		{
+			name:            "emptyEnablesEverything",
+			expectedEnabled: RegionList,
+		},
+		{
+			name:    "normalizesCaseAndSpaces",
+			enabled: []string{" br1", "Kr ", "na1"},
+			expectedEnabled: map[MainRegion][]SubRegion{
+				"AMERICAS": {"BR1", "NA1"},
+				"ASIA":     {"KR"},
+			},
+		},
+		{
+			name:    "ignoresDuplicates",
+			enabled: []string{"BR1", "br1"},
+			expectedEnabled: map[MainRegion][]SubRegion{
+				"AMERICAS": {"BR1"},
+			},
+		},
+		{
+			name:          "unknownRegion",
+			enabled:       []string{"BR1", "XX9"},
+			expectedError: "unknown sub region XX9",
+		},
+		{
+			name:          "onDemandNotEnabled",
+			enabled:       []string{"BR1"},
+			onDemandOnly:  []string{"KR"},
+			expectedError: "on demand region KR must also be enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rc, err := NewRegionConfig(tt.enabled, tt.onDemandOnly)
+
+			if tt.expectedError != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, rc)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, rc.Enabled(), len(tt.expectedEnabled))
+			for mainRegion, subRegions := range tt.expectedEnabled {
+				assert.ElementsMatch(t, subRegions, rc.Enabled()[mainRegion])
+			}
+		})
+	}
+}
+
+func TestIsEnabled(t *testing.T) {
+	rc, err := NewRegionConfig([]string{"BR1", "KR", "EUW1"}, []string{"KR"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		subRegion  SubRegion
+		enabled    bool
+		background bool
+	}{
+		{name: "enabled", subRegion: "BR1", enabled: true, background: true},
+		{name: "onDemandOnly", subRegion: "KR", enabled: true, background: false},
+		{name: "knownButDisabled", subRegion: "NA1", enabled: false, background: false},
+		{name: "unknown", subRegion: "XX9", enabled: false, background: false},
+		{name: "caseSensitive", subRegion: "br1", enabled: false, background: false},
+		{name: "empty", subRegion: "", enabled: false, background: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.enabled, rc.IsEnabled(tt.subRegion))
+			assert.Equal(t, tt.background, rc.IsBackground(tt.subRegion))
+		})
+	}
+}
+
+func TestBackgroundSubRegions(t *testing.T) {
+	rc, err := NewRegionConfig([]string{"BR1", "NA1", "KR"}, []string{"NA1", "KR"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []SubRegion{"BR1"}, rc.BackgroundSubRegions("AMERICAS"))
+	assert.Empty(t, rc.BackgroundSubRegions("ASIA"))
+	assert.Empty(t, rc.BackgroundSubRegions("EUROPE"))
+}
+
+func TestGetParentRegion(t *testing.T) {
+	tests := []struct {
+		subRegion  SubRegion
+		mainRegion MainRegion
+		found      bool
+	}{
+		{subRegion: "BR1", mainRegion: "AMERICAS", found: true},
+		{subRegion: "EUW1", mainRegion: "EUROPE", found: true},
+		{subRegion: "KR", mainRegion: "ASIA", found: true},
+		{subRegion: "OC1", mainRegion: "SEA", found: true},
+		{subRegion: "XX9", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.subRegion), func(t *testing.T) {
+			mainRegion, found := GetParentRegion(tt.subRegion)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.mainRegion, mainRegion)
+		})
+	}
+}
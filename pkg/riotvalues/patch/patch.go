@@ -0,0 +1,35 @@
+package patchvalues
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// A patch is the major and minor part of the game version, like 15.19.
+var patchPattern = regexp.MustCompile(`^\d{1,3}\.\d{1,3}$`)
+
+// FromGameVersion returns the patch of a full game version.
+// The game version is like 15.19.712.2108, returns empty if it can't be parsed.
+func FromGameVersion(gameVersion string) string {
+	parts := strings.SplitN(gameVersion, ".", 3)
+	if len(parts) < 2 {
+		return ""
+	}
+
+	patch := parts[0] + "." + parts[1]
+	if !patchPattern.MatchString(patch) {
+		return ""
+	}
+
+	return patch
+}
+
+// Validate checks if a patch received on a filter is on the stored format.
+func Validate(patch string) error {
+	if !patchPattern.MatchString(patch) {
+		return fmt.Errorf("invalid patch %s, expected the format major.minor like 15.19", patch)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package patchvalues
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromGameVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		gameVersion string
+		expected    string
+	}{
+		{name: "fullVersion", gameVersion: "15.19.712.2108", expected: "15.19"},
+		{name: "shortBuild", gameVersion: "14.1.123", expected: "14.1"},
+		{name: "onlyPatch", gameVersion: "15.19", expected: "15.19"},
+		{name: "empty", gameVersion: "", expected: ""},
+		{name: "onlyMajor", gameVersion: "15", expected: ""},
+		{name: "emptyMinor", gameVersion: "15..1", expected: ""},
+		{name: "notNumeric", gameVersion: "abc.def.1", expected: ""},
+		{name: "minorWithLetters", gameVersion: "15.19a.1", expected: ""},
+		{name: "majorTooLong", gameVersion: "1234.1.1", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FromGameVersion(tt.gameVersion))
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		patch         string
+		expectedError bool
+	}{
+		{name: "valid", patch: "15.19"},
+		{name: "singleDigits", patch: "9.1"},
+		{name: "fullVersion", patch: "14.1.123", expectedError: true},
+		{name: "empty", patch: "", expectedError: true},
+		{name: "onlyMajor", patch: "15", expectedError: true},
+		{name: "trailingDot", patch: "15.", expectedError: true},
+		{name: "notNumeric", patch: "latest", expectedError: true},
+		{name: "withSpaces", patch: " 15.19", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.patch)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package positionvalues
+
+import (
+	"slices"
+	"strings"
+)
+
+// Team positions of the queues with defined roles.
+const (
+	Top     = "TOP"
+	Jungle  = "JUNGLE"
+	Middle  = "MIDDLE"
+	Bottom  = "BOTTOM"
+	Utility = "UTILITY"
+)
+
+// TeamPositions are the positions sent by the Riot API, on the map order.
+var TeamPositions = []string{Top, Jungle, Middle, Bottom, Utility}
+
+// Normalize returns the stored value of a position received on a filter, empty if unknown.
+func Normalize(position string) string {
+	position = strings.ToUpper(strings.TrimSpace(position))
+	if !slices.Contains(TeamPositions, position) {
+		return ""
+	}
+
+	return position
+}
@@ -2,6 +2,7 @@ package tiervalues
 
 import (
 	"fmt"
+	"math"
 	"slices"
 	"strings"
 )
@@ -109,6 +110,11 @@ func GetTierLimits(tier string) (int, int) {
 
 	tierIndex := slices.Index(tierNames, tier)
 
+	// The highest tier has no upper limit.
+	if tierIndex == len(tierNames)-1 {
+		return baseValue, math.MaxInt32
+	}
+
 	nextTier := tierNames[tierIndex+1]
 	return baseValue, tierValues[nextTier] - 1
 }
@@ -0,0 +1,42 @@
+package tiervalues
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTierLimits(t *testing.T) {
+	tests := []struct {
+		tier          string
+		expectedLower int
+		expectedUpper int
+	}{
+		{tier: "IRON", expectedLower: 0, expectedUpper: 9999},
+		{tier: "gold", expectedLower: 30000, expectedUpper: 39999},
+		{tier: " Diamond ", expectedLower: 60000, expectedUpper: 69999},
+		{tier: "GRANDMASTER", expectedLower: 80000, expectedUpper: 89999},
+		// The highest tier has no upper limit, the challenger LP can go above any tier gap.
+		{tier: "CHALLENGER", expectedLower: 90000, expectedUpper: math.MaxInt32},
+		{tier: "UNKNOWN", expectedLower: 0, expectedUpper: 0},
+		{tier: "", expectedLower: 0, expectedUpper: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tier, func(t *testing.T) {
+			lower, upper := GetTierLimits(tt.tier)
+			assert.Equal(t, tt.expectedLower, lower)
+			assert.Equal(t, tt.expectedUpper, upper)
+		})
+	}
+}
+
+func TestGetTierLimitsContainsChallengerRatings(t *testing.T) {
+	lower, upper := GetTierLimits("CHALLENGER")
+
+	// A challenger with more LP than the size of a tier is still inside the challenger limits.
+	rating := CalculateRank("CHALLENGER", "I", 15000)
+	assert.GreaterOrEqual(t, rating, lower)
+	assert.LessOrEqual(t, rating, upper)
+}
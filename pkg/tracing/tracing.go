@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"goleague/pkg/config"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Available exporters.
+const (
+	ExporterNone   = ""
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// tracerName is the instrumentation scope of the manual spans.
+const tracerName = "goleague"
+
+// Init configures the global tracer provider and the propagation of the trace context.
+// Returns a shutdown function that flushes the pending spans.
+func Init(ctx context.Context, cfg config.TracingConfig, serviceName string) (func(context.Context) error, error) {
+	// Always propagate, so a service without exporter doesn't break the trace of the others.
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithInsecure()}
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %s", cfg.Exporter)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create the %s trace exporter: %w", cfg.Exporter, err)
+	}
+
+	res := resource.NewSchemaless(semconv.ServiceName(serviceName))
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span using the global tracer.
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// EndSpan records the error, if any, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -6,11 +6,11 @@ import (
 	"goleague/pkg/config"
 	"goleague/pkg/database"
 	"goleague/pkg/redis"
-	"log"
+	"log/slog"
 )
 
 func RevalidateCache(config *config.Config) error {
-	log.Println("Starting champion cache revalidation")
+	slog.Info("Starting champion cache revalidation")
 	// Create a new connection pool.
 	db, err := database.NewConnection(config.Database.DSN)
 	if err != nil {
@@ -31,17 +31,17 @@ func RevalidateCache(config *config.Config) error {
 
 	err = assets.RevalidateChampionCache(redis, db, "en_US")
 	if err != nil {
-		log.Printf("Error revalidating champion cache: %v", err)
+		slog.Error("Error revalidating champion cache", "error", err)
 	} else {
-		log.Println("Champion cache revalidation completed successfully")
+		slog.Info("Champion cache revalidation completed successfully")
 	}
 
-	log.Println("Starting item cache revalidation")
+	slog.Info("Starting item cache revalidation")
 	err = assets.RevalidateItemCache(redis, db, "en_US")
 	if err != nil {
-		log.Printf("Error revalidating item cache: %v", err)
+		slog.Error("Error revalidating item cache", "error", err)
 	} else {
-		log.Println("Item cache revalidation completed successfully")
+		slog.Info("Item cache revalidation completed successfully")
 	}
 
 	return nil
@@ -5,7 +5,7 @@ import (
 	"goleague/pkg/config"
 	"goleague/pkg/database"
 	tiervalues "goleague/pkg/riotvalues/tier"
-	"log"
+	"log/slog"
 	"time"
 )
 
@@ -20,7 +20,7 @@ const (
 )
 
 func RecalculateFetchPriority(config *config.Config) error {
-	log.Println("Starting fetch priority recalculation")
+	slog.Info("Starting fetch priority recalculation")
 	startTime := time.Now()
 
 	db, err := database.NewConnection(config.Database.DSN)
@@ -46,18 +46,18 @@ func RecalculateFetchPriority(config *config.Config) error {
 	}()
 
 	// Truncate the priority table
-	log.Println("Truncating priority table...")
+	slog.Info("Truncating priority table")
 	if err := tx.Exec("TRUNCATE TABLE player_fetch_priorities").Error; err != nil {
 		tx.Rollback()
 		return fmt.Errorf("failed to truncate: %w", err)
 	}
 
-	log.Println("Dropping index for insert...")
+	slog.Info("Dropping index for insert")
 	// Drop the index in order to speed up inserts.
 	tx.Exec("DROP INDEX IF EXISTS idx_fetch_priorities_query")
 
 	// Rebuild with single INSERT SELECT
-	log.Println("Rebuilding priority table...")
+	slog.Info("Rebuilding priority table")
 	result := tx.Exec(`
         INSERT INTO player_fetch_priorities (
             player_id, 
@@ -95,9 +95,8 @@ func RecalculateFetchPriority(config *config.Config) error {
             ORDER BY player_id,fetch_time DESC
         ) r ON r.player_id = p.id
         LEFT JOIN (
-    		SELECT ms.player_id,MAX(mi.match_start) as last_match
+    		SELECT ms.player_id,MAX(ms.match_start) as last_match
     		FROM match_stats ms
-    		JOIN match_infos mi ON ms.match_id = mi.id
     		GROUP BY ms.player_id
 		) m ON m.player_id = p.id;
     `,
@@ -113,9 +112,9 @@ func RecalculateFetchPriority(config *config.Config) error {
 		return fmt.Errorf("failed to rebuild priorities: %w", result.Error)
 	}
 
-	log.Println("Recreating table index...")
+	slog.Info("Recreating table index")
 	if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_fetch_priorities_query ON player_fetch_priorities (region, fetch_priority DESC, player_id)").Error; err != nil {
-		log.Println("failed to create index: %w", err)
+		slog.Error("Failed to create index", "error", err)
 	}
 
 	if err := tx.Commit().Error; err != nil {
@@ -123,7 +122,7 @@ func RecalculateFetchPriority(config *config.Config) error {
 	}
 
 	duration := time.Since(startTime)
-	log.Printf("Rebuilt %d player priorities in %v", result.RowsAffected, duration)
+	slog.Info("Rebuilt player priorities", "count", result.RowsAffected, "duration", duration)
 
 	var counts struct {
 		Low       int64
@@ -139,8 +138,13 @@ func RecalculateFetchPriority(config *config.Config) error {
 	db.Raw("SELECT COUNT(*) FROM player_fetch_priorities WHERE fetch_priority = ?", PriorityHigh).Scan(&counts.High)
 	db.Raw("SELECT COUNT(*) FROM player_fetch_priorities WHERE fetch_priority = ?", PriorityCritical).Scan(&counts.Critical)
 
-	log.Printf("Distribution - Low: %d, Medium-Low: %d, Medium: %d, High: %d, Critical: %d",
-		counts.Low, counts.MediumLow, counts.Medium, counts.High, counts.Critical)
+	slog.Info("Priority distribution",
+		"low", counts.Low,
+		"medium_low", counts.MediumLow,
+		"medium", counts.Medium,
+		"high", counts.High,
+		"critical", counts.Critical,
+	)
 
 	return nil
 }
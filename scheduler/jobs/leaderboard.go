@@ -0,0 +1,33 @@
+package jobs
+
+import (
+	"fmt"
+	"goleague/pkg/config"
+	"goleague/pkg/database"
+	"log/slog"
+	"time"
+)
+
+// RefreshLeaderboard recomputes the ladder from the latest ratings.
+// The refresh is concurrent, so the leaderboard keeps being served while it runs.
+func RefreshLeaderboard(config *config.Config) error {
+	slog.Info("Starting leaderboard refresh")
+
+	db, err := database.NewConnection(config.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("couldn't get database connection: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	start := time.Now()
+	if err := db.Exec("REFRESH MATERIALIZED VIEW CONCURRENTLY leaderboard_entries").Error; err != nil {
+		return fmt.Errorf("couldn't refresh the leaderboard: %w", err)
+	}
+
+	slog.Info("Finished leaderboard refresh", "duration", time.Since(start))
+	return nil
+}
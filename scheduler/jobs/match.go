@@ -4,12 +4,12 @@ import (
 	"fmt"
 	"goleague/pkg/config"
 	"goleague/pkg/database"
-	"log"
+	"log/slog"
 )
 
 // RecalculateMatchRating calculates the average rating from all matches that happened in the last one day.
 func RecalculateMatchRating(config *config.Config) error {
-	log.Println("Starting recalculate match rating.")
+	slog.Info("Starting recalculate match rating")
 
 	// Create a new connection pool.
 	db, err := database.NewConnection(config.Database.DSN)
@@ -39,6 +39,7 @@ func RecalculateMatchRating(config *config.Config) error {
 	        SELECT ms.player_id
 	        FROM match_stats ms
 	        WHERE ms.match_id = mi.id
+	        AND ms.match_start = mi.match_start
 	      )
 	    ORDER BY re.player_id, ABS(EXTRACT(EPOCH FROM (re.fetch_time - mi.match_start))) ASC
 	  ) sub ON TRUE
@@ -55,6 +56,6 @@ func RecalculateMatchRating(config *config.Config) error {
 	if err != nil {
 		return fmt.Errorf("update of match scores failed: %v", err)
 	}
-	log.Println("Finished job")
+	slog.Info("Finished recalculate match rating")
 	return nil
 }
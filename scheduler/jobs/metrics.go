@@ -0,0 +1,29 @@
+package jobs
+
+import (
+	"goleague/pkg/config"
+	"goleague/pkg/metrics"
+	"log/slog"
+	"time"
+)
+
+// Job is the signature shared by every scheduler job.
+type Job func(config *config.Config) error
+
+// WithMetrics wraps a job, recording its duration and outcome.
+func WithMetrics(name string, job Job) Job {
+	return func(config *config.Config) error {
+		start := time.Now()
+		err := job(config)
+		metrics.ObserveSince(metrics.JobDuration.WithLabelValues(name), start)
+
+		if err != nil {
+			slog.Error("Job failed", "job", name, "error", err)
+			metrics.JobRunsTotal.WithLabelValues(name, "failure").Inc()
+			return err
+		}
+
+		metrics.JobRunsTotal.WithLabelValues(name, "success").Inc()
+		return nil
+	}
+}
@@ -0,0 +1,41 @@
+package jobs
+
+import (
+	"fmt"
+	"goleague/pkg/config"
+	"goleague/pkg/database"
+	"log/slog"
+	"time"
+)
+
+// Partitions are created ahead, so new matches never land on the default partitions.
+const partitionMonthsAhead = 3
+
+// CreateMatchPartitions creates the monthly partitions of the match tables.
+// Covers the current month and the next ones, the existing partitions are kept.
+func CreateMatchPartitions(config *config.Config) error {
+	slog.Info("Starting match partitions creation")
+
+	db, err := database.NewConnection(config.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("couldn't get database connection: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	now := time.Now().UTC()
+	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	for i := range partitionMonthsAhead + 1 {
+		month := currentMonth.AddDate(0, i, 0)
+		if err := db.Exec("SELECT create_match_partitions(?::DATE)", month.Format(time.DateOnly)).Error; err != nil {
+			return fmt.Errorf("couldn't create the partitions of %s: %w", month.Format("2006-01"), err)
+		}
+	}
+
+	slog.Info("Finished match partitions creation", "months", partitionMonthsAhead+1)
+	return nil
+}
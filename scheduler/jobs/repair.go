@@ -0,0 +1,168 @@
+package jobs
+
+import (
+	"fmt"
+	"goleague/pkg/config"
+	"goleague/pkg/database"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// Matches younger than this may still be in the middle of the ingestion.
+	repairGracePeriod = "1 hour"
+	repairBatchSize   = 500
+)
+
+// Timeline event tables, each referencing a match through the match_id column.
+var timelineEventTables = []string{
+	"event_feat_updates",
+	"event_items",
+	"event_kill_structs",
+	"event_level_ups",
+	"event_monster_kills",
+	"event_player_kills",
+	"event_skill_level_ups",
+	"event_wards",
+}
+
+// Tables that reference a match directly through the match_id column, without a partition.
+var matchChildTables = []string{"match_bans", "pending_timelines"}
+
+// matchKey is a match along with its start, the partition key of its stats and timeline.
+type matchKey struct {
+	ID         uint
+	MatchStart time.Time
+}
+
+// splitMatchKeys returns the ids and the starts of the matches.
+// Filtering by the starts as well keeps the deletes on the partitions of the matches.
+func splitMatchKeys(matches []matchKey) ([]uint, []time.Time) {
+	ids := make([]uint, len(matches))
+	starts := make([]time.Time, len(matches))
+	for i, match := range matches {
+		ids[i] = match.ID
+		starts[i] = match.MatchStart
+	}
+
+	return ids, starts
+}
+
+// RepairPartialMatches handles the matches that were never fully fetched.
+// Matches with a deferred timeline are left untouched, they are completed when viewed.
+// Matches with a failed timeline waiting for a retry are left untouched as well, the fetcher resumes their timeline.
+// Matches with a complete timeline are marked as fetched, the others are removed so the fetcher downloads them again.
+func RepairPartialMatches(config *config.Config) error {
+	slog.Info("Starting partial match repair")
+
+	db, err := database.NewConnection(config.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("couldn't get database connection: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	// Only the final step of the ingestion is missing, just complete it.
+	completed := db.Exec(`
+		UPDATE match_infos mi
+		SET fully_fetched = TRUE
+		WHERE mi.fully_fetched IS NOT TRUE
+		AND mi.created_at < NOW() - ?::INTERVAL
+		AND NOT EXISTS (SELECT 1 FROM pending_timelines pt WHERE pt.match_id = mi.id)
+		AND mi.frame_interval > 0
+		AND EXISTS (
+			SELECT 1
+			FROM match_stats ms
+			JOIN participant_frames pf ON pf.match_stat_id = ms.id AND pf.match_start = ms.match_start
+			WHERE ms.match_id = mi.id
+			AND ms.match_start = mi.match_start
+		)
+		AND EXISTS (
+			SELECT 1
+			FROM event_level_ups el
+			WHERE el.match_id = mi.id
+			AND el.match_start = mi.match_start
+		)
+	`, repairGracePeriod)
+	if completed.Error != nil {
+		return fmt.Errorf("couldn't complete the partial matches: %w", completed.Error)
+	}
+
+	// Everything left is missing the timeline, remove it in batches.
+	var removed int
+	for {
+		var matches []matchKey
+		if err := db.Raw(`
+			SELECT id, match_start
+			FROM match_infos mi
+			WHERE mi.fully_fetched IS NOT TRUE
+			AND mi.created_at < NOW() - ?::INTERVAL
+			AND NOT EXISTS (SELECT 1 FROM pending_timelines pt WHERE pt.match_id = mi.id)
+			AND NOT EXISTS (SELECT 1 FROM failed_matches fm WHERE fm.match_id = mi.match_id AND NOT fm.permanent)
+			LIMIT ?
+		`, repairGracePeriod, repairBatchSize).Scan(&matches).Error; err != nil {
+			return fmt.Errorf("couldn't get the partial matches: %w", err)
+		}
+
+		if len(matches) == 0 {
+			break
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return deleteMatches(tx, matches)
+		}); err != nil {
+			return fmt.Errorf("couldn't remove the partial matches: %w", err)
+		}
+
+		removed += len(matches)
+	}
+
+	slog.Info("Finished partial match repair", "completed", completed.RowsAffected, "removed", removed)
+	return nil
+}
+
+// deleteMatches removes the matches and every row attached to them.
+func deleteMatches(tx *gorm.DB, matches []matchKey) error {
+	if err := deleteMatchTimelines(tx, matches); err != nil {
+		return err
+	}
+
+	matchIds, matchStarts := splitMatchKeys(matches)
+	for _, table := range matchChildTables {
+		if err := tx.Exec(fmt.Sprintf("DELETE FROM %s WHERE match_id IN ?", table), matchIds).Error; err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Exec("DELETE FROM match_stats WHERE match_id IN ? AND match_start IN ?", matchIds, matchStarts).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec("DELETE FROM match_infos WHERE id IN ?", matchIds).Error
+}
+
+// deleteMatchTimelines removes the participant frames and events of the matches.
+func deleteMatchTimelines(tx *gorm.DB, matches []matchKey) error {
+	matchIds, matchStarts := splitMatchKeys(matches)
+	if err := tx.Exec(`
+		DELETE FROM participant_frames
+		WHERE match_start IN ?
+		AND match_stat_id IN (SELECT id FROM match_stats WHERE match_id IN ? AND match_start IN ?)
+	`, matchStarts, matchIds, matchStarts).Error; err != nil {
+		return err
+	}
+
+	for _, table := range timelineEventTables {
+		query := fmt.Sprintf("DELETE FROM %s WHERE match_id IN ? AND match_start IN ?", table)
+		if err := tx.Exec(query, matchIds, matchStarts).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
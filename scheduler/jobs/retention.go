@@ -0,0 +1,142 @@
+package jobs
+
+import (
+	"fmt"
+	"goleague/pkg/config"
+	"goleague/pkg/database"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const pruneBatchSize = 500
+
+// PruneMatchTimelines removes the participant frames and events of the matches outside the retention.
+// The match stats are kept, and the match is flagged so the API can tell the timeline was pruned.
+// Each batch runs in its own short transaction, so the tables are never locked for long.
+func PruneMatchTimelines(config *config.Config) error {
+	retention := config.Retention
+	if retention.TimelineDays <= 0 && retention.TimelinePatches <= 0 {
+		slog.Info("Timeline retention disabled, skipping the pruning")
+		return nil
+	}
+
+	slog.Info("Starting match timeline pruning")
+
+	db, err := database.NewConnection(config.Database.DSN)
+	if err != nil {
+		return fmt.Errorf("couldn't get database connection: %w", err)
+	}
+	defer func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+	}()
+
+	cutoff, err := timelineRetentionCutoff(db, retention)
+	if err != nil {
+		return fmt.Errorf("couldn't get the retention cutoff: %w", err)
+	}
+
+	if cutoff == nil {
+		slog.Info("No match outside the timeline retention")
+		return nil
+	}
+
+	var pruned int
+	for {
+		// Partial matches are left for the repair job, unless the timeline was deferred.
+		var matches []matchKey
+		if err := db.Raw(`
+			SELECT id, match_start
+			FROM match_infos mi
+			WHERE mi.timeline_pruned = FALSE
+			AND mi.match_start < ?
+			AND (
+				mi.fully_fetched IS TRUE
+				OR EXISTS (SELECT 1 FROM pending_timelines pt WHERE pt.match_id = mi.id)
+			)
+			LIMIT ?
+		`, *cutoff, pruneBatchSize).Scan(&matches).Error; err != nil {
+			return fmt.Errorf("couldn't get the matches to prune: %w", err)
+		}
+
+		if len(matches) == 0 {
+			break
+		}
+
+		if err := db.Transaction(func(tx *gorm.DB) error {
+			return pruneTimelines(tx, matches)
+		}); err != nil {
+			return fmt.Errorf("couldn't prune the match timelines: %w", err)
+		}
+
+		pruned += len(matches)
+	}
+
+	slog.Info("Finished match timeline pruning", "cutoff", *cutoff, "pruned", pruned)
+	return nil
+}
+
+// timelineRetentionCutoff returns the start date before which the timelines are pruned.
+// With both windows enabled the earliest date is used, a match is kept while inside any of them.
+// Returns nil when no match is outside the retention.
+func timelineRetentionCutoff(db *gorm.DB, retention config.RetentionConfig) (*time.Time, error) {
+	var cutoff *time.Time
+
+	if retention.TimelineDays > 0 {
+		byDays := time.Now().AddDate(0, 0, -retention.TimelineDays)
+		cutoff = &byDays
+	}
+
+	if retention.TimelinePatches > 0 {
+		// The patches are ordered numerically, 15.10 is newer than 15.9.
+		var byPatches *time.Time
+		if err := db.Raw(`
+			WITH latest AS (
+				SELECT patch
+				FROM match_infos
+				WHERE patch <> ''
+				GROUP BY patch
+				ORDER BY string_to_array(patch, '.')::INT[] DESC
+				LIMIT ?
+			)
+			SELECT MIN(match_start)
+			FROM match_infos
+			WHERE patch IN (SELECT patch FROM latest)
+		`, retention.TimelinePatches).Scan(&byPatches).Error; err != nil {
+			return nil, err
+		}
+
+		// No patch known yet, nothing can be safely pruned.
+		if byPatches == nil {
+			return nil, nil
+		}
+
+		if cutoff == nil || byPatches.Before(*cutoff) {
+			cutoff = byPatches
+		}
+	}
+
+	return cutoff, nil
+}
+
+// pruneTimelines removes the timeline of the matches and flags them as pruned.
+// Deferred timelines are dropped as well, the matches are complete from now on.
+func pruneTimelines(tx *gorm.DB, matches []matchKey) error {
+	if err := deleteMatchTimelines(tx, matches); err != nil {
+		return err
+	}
+
+	matchIds, _ := splitMatchKeys(matches)
+	if err := tx.Exec("DELETE FROM pending_timelines WHERE match_id IN ?", matchIds).Error; err != nil {
+		return err
+	}
+
+	return tx.Exec(`
+		UPDATE match_infos
+		SET timeline_pruned = TRUE, fully_fetched = TRUE
+		WHERE id IN ?
+	`, matchIds).Error
+}
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"context"
 	"goleague/pkg/config"
 	"goleague/pkg/database"
+	"goleague/pkg/logger"
+	"goleague/pkg/metrics"
+	"goleague/pkg/tracing"
 	"goleague/scheduler/jobs"
 	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
@@ -19,6 +24,19 @@ func main() {
 		log.Fatalf("Couldn't initialize the configuration: %v", err)
 	}
 
+	// Jobs log through the default logger.
+	schedulerLogger, err := logger.NewStreaming(cfg, "service", "scheduler")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the logger: %v", err)
+	}
+	slog.SetDefault(schedulerLogger.Logger)
+
+	shutdownTracing, err := tracing.Init(context.Background(), cfg.Tracing, "goleague-scheduler")
+	if err != nil {
+		log.Fatalf("Couldn't initialize the tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	db, err := database.NewConnection(cfg.Database.DSN)
 	if err != nil {
 		log.Fatal(err)
@@ -34,7 +52,10 @@ func main() {
 		log.Fatal(err)
 	}
 
-	log.Println("Starting scheduler.")
+	// Expose the metrics.
+	metrics.StartServer(cfg.Metrics.Port)
+
+	schedulerLogger.Info("Starting scheduler")
 
 	// Create a new scheduler with options.
 	s, err := gocron.NewScheduler(
@@ -44,6 +65,26 @@ func main() {
 		log.Fatalf("Failed to create scheduler: %v", err)
 	}
 
+	// Create the match partitions ahead of time - once per day at 2:00 AM.
+	_, err = s.NewJob(
+		gocron.DailyJob(
+			1,
+			gocron.NewAtTimes(
+				gocron.NewAtTime(2, 0, 0),
+			),
+		),
+		gocron.NewTask(
+			jobs.WithMetrics("match-partitions", jobs.CreateMatchPartitions),
+			cfg,
+		),
+		gocron.WithName("match-partitions"),
+		gocron.WithTags("partitions"),
+		gocron.JobOption(gocron.WithStartImmediately()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create match partitions job: %v", err)
+	}
+
 	// Register champion cache revalidation job - once per day at 3:00 AM.
 	_, err = s.NewJob(
 		gocron.DailyJob(
@@ -53,7 +94,7 @@ func main() {
 			),
 		),
 		gocron.NewTask(
-			jobs.RevalidateCache,
+			jobs.WithMetrics("cache-revalidation", jobs.RevalidateCache),
 			cfg,
 		),
 		gocron.WithName("cache-revalidation"),
@@ -73,7 +114,7 @@ func main() {
 			),
 		),
 		gocron.NewTask(
-			jobs.RecalculateMatchRating,
+			jobs.WithMetrics("match-rating-revalidation", jobs.RecalculateMatchRating),
 			cfg,
 		),
 		gocron.WithName("match-rating-revalidation"),
@@ -92,7 +133,7 @@ func main() {
 			),
 		),
 		gocron.NewTask(
-			jobs.RecalculateFetchPriority,
+			jobs.WithMetrics("fetch-priority-revalidation", jobs.RecalculateFetchPriority),
 			cfg,
 		),
 		gocron.WithName("fetch-priority-revalidation"),
@@ -103,6 +144,54 @@ func main() {
 		log.Fatalf("Failed to create fetch priority revalidation job: %v", err)
 	}
 
+	// Repair the matches left behind by interrupted ingestions - every hour.
+	_, err = s.NewJob(
+		gocron.DurationJob(time.Hour),
+		gocron.NewTask(
+			jobs.WithMetrics("partial-match-repair", jobs.RepairPartialMatches),
+			cfg,
+		),
+		gocron.WithName("partial-match-repair"),
+		gocron.WithTags("repair"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create partial match repair job: %v", err)
+	}
+
+	// Refresh the leaderboard with the latest ratings - every hour.
+	_, err = s.NewJob(
+		gocron.DurationJob(time.Hour),
+		gocron.NewTask(
+			jobs.WithMetrics("leaderboard-refresh", jobs.RefreshLeaderboard),
+			cfg,
+		),
+		gocron.WithName("leaderboard-refresh"),
+		gocron.WithTags("leaderboard"),
+		gocron.JobOption(gocron.WithStartImmediately()),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create leaderboard refresh job: %v", err)
+	}
+
+	// Prune the timelines outside the retention - once per day at 5:00 AM.
+	_, err = s.NewJob(
+		gocron.DailyJob(
+			1,
+			gocron.NewAtTimes(
+				gocron.NewAtTime(5, 0, 0),
+			),
+		),
+		gocron.NewTask(
+			jobs.WithMetrics("timeline-retention", jobs.PruneMatchTimelines),
+			cfg,
+		),
+		gocron.WithName("timeline-retention"),
+		gocron.WithTags("retention"),
+	)
+	if err != nil {
+		log.Fatalf("Failed to create timeline retention job: %v", err)
+	}
+
 	// Start the scheduler.
 	s.Start()
 
@@ -110,7 +199,7 @@ func main() {
 		// Shutdown the scheduler when main() exits.
 		err := s.Shutdown()
 		if err != nil {
-			log.Printf("Error shutting down scheduler: %v", err)
+			schedulerLogger.Error("Error shutting down scheduler", "error", err)
 		}
 	}()
 
@@ -120,5 +209,5 @@ func main() {
 
 	// Wait for termination signal.
 	<-sigChan
-	log.Println("Shutting down scheduler...")
+	schedulerLogger.Info("Shutting down scheduler")
 }